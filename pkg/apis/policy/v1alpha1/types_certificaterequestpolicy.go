@@ -29,6 +29,7 @@ var CertificateRequestPolicyKind = "CertificateRequestPolicy"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 //+kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type == "Ready")].status`,description="CertificateRequestPolicy is ready for evaluation"
+// +kubebuilder:printcolumn:name="Active",type="string",JSONPath=`.status.conditions[?(@.type == "Active")].status`,description="CertificateRequestPolicy is within its active period and schedule"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Timestamp CertificateRequestPolicy was created"
 //+kubebuilder:resource:categories=cert-manager,shortName=crp,scope=Cluster
 //+kubebuilder:subresource:status
@@ -55,6 +56,69 @@ type CertificateRequestPolicyList struct {
 // CertificateRequestPolicySpec defines the desired state of
 // CertificateRequestPolicy.
 type CertificateRequestPolicySpec struct {
+	// Effect defines what happens to CertificateRequests matched by this
+	// policy. One of `Allow` or `Deny`. Defaults to `Allow`.
+	//
+	// An `Allow` policy approves requests which conform to the policy.
+	//
+	// A `Deny` policy describes requests which must never be approved. Deny
+	// policies are evaluated before any Allow policy, and a request which
+	// matches a Deny policy is denied regardless of any Allow policy that
+	// would have approved it. A request matches a Deny policy when it is
+	// selected by the policy Selector and every evaluator of the policy
+	// passes. For Deny policies, each `allowed` field that is defined is a
+	// condition that matches if _any_ of the requested values of that field
+	// match, rather than requiring _all_ requested values to match. Omitted
+	// `allowed` fields are ignored. A condition or validation which fails to
+	// evaluate, such as a CEL rule which errors, is taken as matched. Deny
+	// policies apply to all requesters, and do not need to be bound to the
+	// requesting user with RBAC.
+	// +optional
+	Effect CertificateRequestPolicyEffect `json:"effect,omitempty"`
+
+	// Enforcement defines whether the decisions of this policy are enforced.
+	// One of `Enforce` or `Audit`. Defaults to `Enforce`.
+	//
+	// An `Audit` policy is evaluated against CertificateRequests in the same way
+	// as an `Enforce` policy, but never approves or denies a request. Instead,
+	// the decision the policy would have made is recorded as an event on the
+	// CertificateRequest, in the `policy.cert-manager.io/audit` annotation of
+	// the CertificateRequest, and in the
+	// `approverpolicy_certificaterequest_audit_count` metric.
+	// Useful for rolling out changes to policies without risking denying
+	// requests.
+	// +optional
+	Enforcement CertificateRequestPolicyEnforcement `json:"enforcement,omitempty"`
+
+	// Priority defines the order in which this policy is evaluated against
+	// CertificateRequests, relative to other policies of the same effect.
+	// Policies with a higher priority are evaluated first. Policies of the same
+	// priority are evaluated in order of their name. A CertificateRequest is
+	// approved by the first policy in this order which approves it.
+	// Defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// ValidFrom is the time from which this policy is active. A policy which
+	// is not active is not evaluated against CertificateRequests.
+	// An omitted field means the policy is active from its creation.
+	// +optional
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil is the time from which this policy is no longer active.
+	// Useful for granting temporary access without having to remember to
+	// delete the policy. Must be after ValidFrom if both are defined.
+	// An omitted field means the policy remains active indefinitely.
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
+	// Schedule restricts this policy to be active only within recurring
+	// windows of time, e.g. weekdays 09:00-17:00. Schedule applies within the
+	// period given by ValidFrom and ValidUntil.
+	// An omitted field means the policy is active at all times.
+	// +optional
+	Schedule *CertificateRequestPolicySchedule `json:"schedule,omitempty"`
+
 	// Allowed defines the allowed attributes for a CertificateRequest.
 	// A CertificateRequest can request _less_ than what is allowed,
 	// but _not more_, i.e. a CertificateRequest can request a subset of what
@@ -72,6 +136,45 @@ type CertificateRequestPolicySpec struct {
 	// +optional
 	Constraints *CertificateRequestPolicyConstraints `json:"constraints,omitempty"`
 
+	// Limits define the maximum rate at which CertificateRequests are
+	// approved. A request which this policy would approve, but which would
+	// exceed a limit, is neither approved nor denied, and is reviewed again
+	// once the limit allows it.
+	// Limits are best-effort, as approvals are counted from the informer
+	// cache. A burst of requests which are reviewed before earlier approvals
+	// are observed may exceed a limit.
+	// Limits may not be defined on Deny policies.
+	// An omitted field places no limits on approvals.
+	// +optional
+	Limits *CertificateRequestPolicyLimits `json:"limits,omitempty"`
+
+	// ManualApproval requires requests which this policy would approve to
+	// also be approved by people, before they are approved. Such requests are
+	// left pending until enough approvers have voted to approve them with
+	// CertificateRequestVotes, and are denied if any approver votes to deny
+	// them, even if another policy would approve them.
+	// ManualApproval may not be defined on Deny policies.
+	// An omitted field approves requests without manual approval.
+	// +optional
+	ManualApproval *CertificateRequestPolicyManualApproval `json:"manualApproval,omitempty"`
+
+	// Validations applies rules using Common Expression Language (CEL) to the
+	// whole CertificateRequest. All rules must evaluate to true for the
+	// request to be allowed by this policy. Unlike validations on `allowed`
+	// fields, there is no `self` variable; rules are written against the `cr`
+	// variable which also contains the decoded CSR, allowing rules to span
+	// multiple attributes of the request.
+	// For Deny policies, the request matches when all rules evaluate to true.
+	//
+	// Example (common name must be one of the DNS names):
+	// ```
+	// rule: "cr.csr.subject.commonName == '' || cr.csr.subject.commonName in cr.csr.dnsNames"
+	// ```
+	// +listType=map
+	// +listMapKey=rule
+	// +optional
+	Validations []ValidationRule `json:"validations,omitempty"`
+
 	// Plugins are approvers that are built into approver-policy at
 	// compile-time. This is an advanced feature typically used to extend
 	// approver-policy core features. This field define plugins and their
@@ -86,6 +189,87 @@ type CertificateRequestPolicySpec struct {
 	Selector CertificateRequestPolicySelector `json:"selector"`
 }
 
+// CertificateRequestPolicyEffect is the effect a CertificateRequestPolicy
+// has on the CertificateRequests it matches.
+// +kubebuilder:validation:Enum=Allow;Deny
+type CertificateRequestPolicyEffect string
+
+const (
+	// CertificateRequestPolicyEffectAllow approves CertificateRequests which
+	// conform to the CertificateRequestPolicy.
+	CertificateRequestPolicyEffectAllow CertificateRequestPolicyEffect = "Allow"
+
+	// CertificateRequestPolicyEffectDeny denies CertificateRequests which match
+	// the CertificateRequestPolicy.
+	CertificateRequestPolicyEffectDeny CertificateRequestPolicyEffect = "Deny"
+)
+
+// CertificateRequestPolicyMatchMode defines how wildcards "*" in patterns are
+// matched.
+// +kubebuilder:validation:Enum=Glob;DNS
+type CertificateRequestPolicyMatchMode string
+
+const (
+	// CertificateRequestPolicyMatchModeGlob matches "*" against any sequence
+	// of characters, including dots. For example, "*.example.com" matches
+	// "a.b.example.com".
+	CertificateRequestPolicyMatchModeGlob CertificateRequestPolicyMatchMode = "Glob"
+
+	// CertificateRequestPolicyMatchModeDNS matches patterns label by label.
+	// A "*" label matches exactly one label, and a "**" label matches one or
+	// more labels. Wildcards must be entire labels. For example,
+	// "*.example.com" matches "a.example.com" but not "a.b.example.com", which
+	// is matched by "**.example.com". Matching ignores case and a trailing
+	// dot. Names are mapped as defined by UTS #46 for lookup, and
+	// internationalized names are matched in their ASCII (punycode) form.
+	// Names which cannot be mapped are never allowed by Allow policies, and
+	// always match Deny policies.
+	CertificateRequestPolicyMatchModeDNS CertificateRequestPolicyMatchMode = "DNS"
+)
+
+// CertificateRequestPolicyEnforcement defines whether the decisions of a
+// CertificateRequestPolicy are enforced.
+// +kubebuilder:validation:Enum=Enforce;Audit
+type CertificateRequestPolicyEnforcement string
+
+const (
+	// CertificateRequestPolicyEnforcementEnforce approves or denies
+	// CertificateRequests based on the decision of the CertificateRequestPolicy.
+	CertificateRequestPolicyEnforcementEnforce CertificateRequestPolicyEnforcement = "Enforce"
+
+	// CertificateRequestPolicyEnforcementAudit only records the decision the
+	// CertificateRequestPolicy would have made on CertificateRequests.
+	CertificateRequestPolicyEnforcementAudit CertificateRequestPolicyEnforcement = "Audit"
+)
+
+// CertificateRequestAuditAnnotationKey is the annotation key on
+// CertificateRequests which records the decisions that Audit
+// CertificateRequestPolicies would have made on the request. The value is a
+// JSON encoded list of CertificateRequestPolicyAuditResults.
+const CertificateRequestAuditAnnotationKey = "policy.cert-manager.io/audit"
+
+// CertificateRequestPolicyAuditResult is the decision an Audit
+// CertificateRequestPolicy would have made on a CertificateRequest, had it
+// been enforced.
+// +kubebuilder:object:generate=false
+type CertificateRequestPolicyAuditResult struct {
+	// Kind is the kind of the policy, either `CertificateRequestPolicy` or
+	// `NamespacedCertificateRequestPolicy`.
+	Kind string `json:"kind"`
+
+	// Name is the name of the policy.
+	Name string `json:"name"`
+
+	// Result is the decision the policy would have made, either `Approved` or
+	// `Denied`.
+	Result string `json:"result"`
+
+	// Message is optional context as to why the policy would have made the
+	// decision.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // CertificateRequestPolicyAllowed defines the allowed attributes for a
 // CertificateRequest.
 // A CertificateRequest can request _less_ than what is allowed,
@@ -95,6 +279,8 @@ type CertificateRequestPolicySpec struct {
 // be omitted or have an empty value for the request to be permitted.
 type CertificateRequestPolicyAllowed struct {
 	// CommonName defines the X.509 Common Name that may be requested.
+	// If the subject contains more than one Common Name, each of them must
+	// be allowed.
 	// +optional
 	CommonName *CertificateRequestPolicyAllowedString `json:"commonName,omitempty"`
 
@@ -102,18 +288,38 @@ type CertificateRequestPolicyAllowed struct {
 	// +optional
 	DNSNames *CertificateRequestPolicyAllowedStringSlice `json:"dnsNames,omitempty"`
 
+	// DNSNameMatching defines how the values of `dnsNames` are matched
+	// against the requested DNS SANs, and whether wildcard DNS SANs may be
+	// requested.
+	// +optional
+	DNSNameMatching *CertificateRequestPolicyAllowedDNSNameMatching `json:"dnsNameMatching,omitempty"`
+
 	// IPAddresses defines the X.509 IP SANs that may be requested.
+	// Values may be CIDRs (e.g. "10.20.0.0/16" or "fd00::/8"), which contain
+	// the IP addresses in their prefix, or IP addresses. Values containing
+	// wildcards "*" are matched against the canonical form of IP addresses.
+	// IPv4-mapped IPv6 addresses are matched as their IPv4 equivalent.
 	// +optional
 	IPAddresses *CertificateRequestPolicyAllowedStringSlice `json:"ipAddresses,omitempty"`
 
 	// URIs defines the X.509 URI SANs that may be requested.
+	// Values and validations are matched against the string form of the
+	// URIs. Matchers are matched against the parsed and normalised URIs.
 	// +optional
-	URIs *CertificateRequestPolicyAllowedStringSlice `json:"uris,omitempty"`
+	URIs *CertificateRequestPolicyAllowedURIs `json:"uris,omitempty"`
 
 	// EmailAddresses defines the X.509 Email SANs that may be requested.
 	// +optional
 	EmailAddresses *CertificateRequestPolicyAllowedStringSlice `json:"emailAddresses,omitempty"`
 
+	// OtherNames defines the X.509 otherName SANs, identified by their type
+	// OID, that may be requested. An otherName SAN whose type is not listed
+	// is forbidden.
+	// +listType=map
+	// +listMapKey=oid
+	// +optional
+	OtherNames []CertificateRequestPolicyAllowedOtherName `json:"otherNames,omitempty"`
+
 	// IsCA defines if a CertificateRequest is allowed to set the `spec.isCA`
 	// field set to `true`.
 	// If `true`, the `spec.isCA` field can be `true` or `false`.
@@ -137,6 +343,163 @@ type CertificateRequestPolicyAllowed struct {
 	// attributes.
 	// +optional
 	Subject *CertificateRequestPolicyAllowedX509Subject `json:"subject,omitempty"`
+
+	// Extensions defines the X.509 extensions, identified by their OID, that
+	// may be requested in the CSR of a CertificateRequest.
+	// The Subject Alternative Name, Key Usage, Extended Key Usage and Basic
+	// Constraints extensions are evaluated by the other allowed attributes, so
+	// are permitted unless they are listed here.
+	// +listType=map
+	// +listMapKey=oid
+	// +optional
+	Extensions []CertificateRequestPolicyAllowedExtension `json:"extensions,omitempty"`
+
+	// DenyUnknownExtensions enables strict extension checking. If `true`, a
+	// CertificateRequest whose CSR carries any extension which is not listed
+	// in `extensions` is denied, other than the extensions evaluated by the
+	// other allowed attributes.
+	// Defaults to `false`, where extensions which are not listed are ignored.
+	// +optional
+	DenyUnknownExtensions *bool `json:"denyUnknownExtensions,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedExtension declares an X.509 extension that
+// may be requested in the CSR of a CertificateRequest.
+type CertificateRequestPolicyAllowedExtension struct {
+	// OID is the object identifier of the extension in dotted decimal
+	// notation, for example "1.3.6.1.4.1.311.20.2".
+	// +kubebuilder:validation:Pattern=`^[0-2](\.(0|[1-9][0-9]*))+$`
+	OID string `json:"oid"`
+
+	// Values defines the allowed values of the extension. Extension values are
+	// matched as the lowercase hexadecimal encoding of their DER value.
+	// Accepts wildcards "*".
+	// If set, the value of the extension must match one of the values.
+	// If unset, the extension may have any value.
+	// +listType=atomic
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// Validations applies rules using Common Expression Language (CEL) to
+	// validate the value of the extension, where `self` is the lowercase
+	// hexadecimal encoding of its DER value.
+	// The extension must pass ALL validations for the request to be granted
+	// by this policy.
+	// +listType=map
+	// +listMapKey=rule
+	// +optional
+	Validations []ValidationRule `json:"validations,omitempty"`
+
+	// Required controls whether the extension must be requested.
+	// Defaults to `false`.
+	// +optional
+	Required *bool `json:"required,omitempty"`
+
+	// Critical defines whether the extension may be marked as critical.
+	// If `true`, the extension may be critical or non-critical.
+	// If `false` or unset, the extension must not be critical.
+	// +optional
+	Critical *bool `json:"critical,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedDNSNameMatching defines how DNS SANs are
+// matched.
+type CertificateRequestPolicyAllowedDNSNameMatching struct {
+	// Mode defines how wildcards in the values of `dnsNames` are matched. One
+	// of `Glob` or `DNS`. Defaults to `Glob`.
+	// +optional
+	Mode CertificateRequestPolicyMatchMode `json:"mode,omitempty"`
+
+	// AllowWildcardNames controls whether wildcard DNS SANs, such as
+	// "*.example.com", may be requested.
+	// Defaults to `true` in `Glob` mode and `false` in `DNS` mode.
+	// Ignored by Deny policies, which match wildcard DNS SANs against
+	// `dnsNames` like any other name.
+	// +optional
+	AllowWildcardNames *bool `json:"allowWildcardNames,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedURIs declares the X.509 URI SANs that may be
+// requested.
+type CertificateRequestPolicyAllowedURIs struct {
+	CertificateRequestPolicyAllowedStringSlice `json:",inline"`
+
+	// Matchers define structured constraints on the requested URI SANs. If
+	// set, every requested URI must match at least one matcher, in addition
+	// to `values` and `validations` if they are defined.
+	// A required field with matchers does not need `values` or
+	// `validations`.
+	// +listType=atomic
+	// +optional
+	Matchers []CertificateRequestPolicyAllowedURIMatcher `json:"matchers,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedURIMatcher matches a URI SAN by its parsed
+// components. URIs are normalised before matching: the scheme and host are
+// lowercased and internationalised hosts are mapped as defined by UTS #46 and
+// converted to punycode. URIs with a path that is not clean (e.g. containing
+// "." or ".." segments, or empty segments) or an escaped "/" are never
+// allowed by Allow policies.
+// Matchers of Deny policies match URIs which cannot be parsed, or whose host
+// cannot be mapped or whose path is not clean, and ignore AllowQuery,
+// AllowFragment and AllowUserinfo, so that such URIs can't be used to avoid
+// them.
+type CertificateRequestPolicyAllowedURIMatcher struct {
+	// Scheme is the scheme of the URI, e.g. "spiffe". Matched
+	// case-insensitively.
+	// +kubebuilder:validation:MinLength=1
+	Scheme string `json:"scheme"`
+
+	// Host is a pattern matched against the host of the URI, including the
+	// port if any. A "*" label matches exactly one DNS label and a "**"
+	// label matches one or more DNS labels, e.g. "*.cluster.local".
+	// If omitted, any host is matched.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// PathPrefix is a prefix that the path of the URI must start with, e.g.
+	// "/ns/sandbox/". The prefix is matched on whole path segments, so
+	// "/ns/sandbox" matches "/ns/sandbox/sa/foo" but not "/ns/sandboxevil".
+	// For URIs without a hierarchical path, such as "urn:uuid:...", the
+	// opaque part of the URI is matched on ":" separated segments.
+	// If omitted, any path is matched.
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+
+	// Path is a pattern matched against the path of the URI. A "*" segment
+	// matches exactly one path segment and a "**" segment matches one or
+	// more path segments, e.g. "/ns/*/sa/*".
+	// If omitted, any path is matched.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// AllowQuery controls whether the URI may have a query component.
+	// Defaults to `false`.
+	// +optional
+	AllowQuery *bool `json:"allowQuery,omitempty"`
+
+	// AllowFragment controls whether the URI may have a fragment component.
+	// Defaults to `false`.
+	// +optional
+	AllowFragment *bool `json:"allowFragment,omitempty"`
+
+	// AllowUserinfo controls whether the URI may have a userinfo component.
+	// Defaults to `false`.
+	// +optional
+	AllowUserinfo *bool `json:"allowUserinfo,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedOtherName declares the allowed values of the
+// X.509 otherName SANs of a type, such as the Microsoft User Principal Name
+// "1.3.6.1.4.1.311.20.2.3".
+// Values of string types are matched as strings. Values of any other type are
+// matched as the lowercase hexadecimal encoding of their DER value.
+type CertificateRequestPolicyAllowedOtherName struct {
+	// OID is the type OID of the otherName SAN in dotted decimal notation.
+	// +kubebuilder:validation:Pattern=`^[0-2](\.(0|[1-9][0-9]*))+$`
+	OID string `json:"oid"`
+
+	CertificateRequestPolicyAllowedStringSlice `json:",inline"`
 }
 
 // CertificateRequestPolicyAllowedX509Subject declares allowed X.509 Subject
@@ -176,9 +539,55 @@ type CertificateRequestPolicyAllowedX509Subject struct {
 	PostalCodes *CertificateRequestPolicyAllowedStringSlice `json:"postalCodes,omitempty"`
 
 	// SerialNumber defines the X.509 Subject Serial Number that may be
-	// requested.
+	// requested. If the subject contains more than one Serial Number, each of
+	// them must be allowed.
 	// +optional
 	SerialNumber *CertificateRequestPolicyAllowedString `json:"serialNumber,omitempty"`
+
+	// DomainComponents defines the X.509 Subject Domain Components (DC) that
+	// may be requested.
+	// +optional
+	DomainComponents *CertificateRequestPolicyAllowedStringSlice `json:"domainComponents,omitempty"`
+
+	// UserIDs defines the X.509 Subject User IDs (UID) that may be requested.
+	// +optional
+	UserIDs *CertificateRequestPolicyAllowedStringSlice `json:"userIDs,omitempty"`
+
+	// Titles defines the X.509 Subject Titles that may be requested.
+	// +optional
+	Titles *CertificateRequestPolicyAllowedStringSlice `json:"titles,omitempty"`
+
+	// EmailAddresses defines the X.509 Subject Email Addresses that may be
+	// requested. These are distinct from Email SANs.
+	// +optional
+	EmailAddresses *CertificateRequestPolicyAllowedStringSlice `json:"emailAddresses,omitempty"`
+
+	// Attributes defines other X.509 Subject attributes, identified by their
+	// OID, that may be requested. Attributes which have a dedicated field
+	// cannot be listed.
+	// +listType=map
+	// +listMapKey=oid
+	// +optional
+	Attributes []CertificateRequestPolicyAllowedSubjectAttribute `json:"attributes,omitempty"`
+
+	// DenyUnknownAttributes enables strict subject checking. If `true`, a
+	// CertificateRequest whose subject carries any attribute which neither
+	// has a dedicated field nor is listed in `attributes` is denied.
+	// Defaults to `false`, where attributes which are not listed are ignored.
+	// +optional
+	DenyUnknownAttributes *bool `json:"denyUnknownAttributes,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedSubjectAttribute declares the allowed values
+// of an X.509 Subject attribute.
+// Values of string types are matched as strings. Values of any other type are
+// matched as the lowercase hexadecimal encoding of their DER value.
+type CertificateRequestPolicyAllowedSubjectAttribute struct {
+	// OID is the type OID of the attribute in dotted decimal notation.
+	// +kubebuilder:validation:Pattern=`^[0-2](\.(0|[1-9][0-9]*))+$`
+	OID string `json:"oid"`
+
+	CertificateRequestPolicyAllowedStringSlice `json:",inline"`
 }
 
 // CertificateRequestPolicyAllowedStringSlice represents allowed string values
@@ -248,13 +657,22 @@ type ValidationRule struct {
 	// The Rule is scoped to the location of the validations in the schema.
 	// The `self` variable in the CEL expression is bound to the scoped value.
 	// To enable more advanced validation rules, approver-policy provides the
-	// `cr` (map) variable to the CEL expression containing `namespace` and
-	// `name` of the `CertificateRequest` resource.
+	// `cr` (map) variable to the CEL expression containing the `name`,
+	// `namespace`, `username`, `groups`, `uid`, `extra`, `issuerRef`,
+	// `duration`, `isCA`, `usages`, `annotations` and `labels` of the
+	// `CertificateRequest` resource. `cr.csr` contains the decoded `subject`,
+	// `dnsNames`, `ipAddresses`, `uris`, `emailAddresses`,
+	// `publicKeyAlgorithm` and `publicKeySize` of the request's CSR.
 	//
 	// Example (rule for namespaced DNSNames):
 	// ```
 	// rule: self.endsWith(cr.namespace + '.svc.cluster.local')
 	// ```
+	//
+	// Example (rule for namespaced DNSNames, unless in group):
+	// ```
+	// rule: "'platform-admins' in cr.groups || self.startsWith(cr.namespace + '.')"
+	// ```
 	Rule string `json:"rule"`
 
 	// Message is the message to display when validation fails.
@@ -293,6 +711,64 @@ type CertificateRequestPolicyConstraints struct {
 	// An omitted field applies no private key shape constraints.
 	// +optional
 	PrivateKey *CertificateRequestPolicyConstraintsPrivateKey `json:"privateKey,omitempty"`
+
+	// SignatureAlgorithm defines constraints on the algorithm used to sign
+	// the CSR of a CertificateRequest.
+	// An omitted field permits any signature algorithm.
+	// +optional
+	SignatureAlgorithm *CertificateRequestPolicyConstraintsSignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
+
+	// PublicKeyReuse defines constraints on reusing the public key of a
+	// previously approved CertificateRequest.
+	// Public key reuse is checked best-effort, as approved requests are looked
+	// up in the informer cache. Requests with the same public key which are
+	// reviewed before earlier approvals are observed may all be approved.
+	// An omitted field permits public keys to be reused.
+	// +optional
+	PublicKeyReuse *CertificateRequestPolicyConstraintsPublicKeyReuse `json:"publicKeyReuse,omitempty"`
+}
+
+// CertificateRequestPolicyConstraintsPublicKeyReuse defines constraints on
+// reusing the public key of previously approved CertificateRequests. Public
+// keys are compared by the fingerprint of their SubjectPublicKeyInfo.
+// Constraints are best-effort: as approved requests are looked up in the
+// informer cache, requests reviewed before earlier approvals are observed may
+// reuse a public key.
+type CertificateRequestPolicyConstraintsPublicKeyReuse struct {
+	// DenyAcrossNamespaces denies a public key which is used by an approved
+	// CertificateRequest in another namespace.
+	// Defaults to `false`.
+	// +optional
+	DenyAcrossNamespaces *bool `json:"denyAcrossNamespaces,omitempty"`
+
+	// DenyWithinCertificate denies a public key which is used by an approved
+	// CertificateRequest of the same Certificate, so that the private key
+	// must be rotated on every renewal. CertificateRequests which are not
+	// owned by a Certificate are not constrained.
+	// Defaults to `false`.
+	// +optional
+	DenyWithinCertificate *bool `json:"denyWithinCertificate,omitempty"`
+}
+
+// CertificateRequestPolicyConstraintsSignatureAlgorithm defines constraints on
+// the signature algorithm of the CSR of a CertificateRequest.
+// Signature algorithms are named as in Go's crypto/x509 package, e.g.
+// "SHA256-RSA" (RSA PKCS#1 v1.5), "SHA256-RSAPSS", "ECDSA-SHA256" or
+// "Ed25519". Values may contain wildcards "*", e.g. "*SHA1*" or "*-RSA".
+type CertificateRequestPolicyConstraintsSignatureAlgorithm struct {
+	// Allowed defines the signature algorithms which may be used. If set,
+	// the signature algorithm must match at least one of the values.
+	// An omitted field permits any signature algorithm which is not
+	// forbidden.
+	// +listType=atomic
+	// +optional
+	Allowed []string `json:"allowed,omitempty"`
+
+	// Forbidden defines the signature algorithms which may not be used, even
+	// if they are allowed.
+	// +listType=atomic
+	// +optional
+	Forbidden []string `json:"forbidden,omitempty"`
 }
 
 // CertificateRequestPolicyConstraintsPrivateKey defines constraints on the shape of private key
@@ -317,6 +793,80 @@ type CertificateRequestPolicyConstraintsPrivateKey struct {
 	// An omitted field applies no maximum constraint on size.
 	// +optional
 	MaxSize *int `json:"maxSize,omitempty"`
+
+	// Curves defines the named curves allowed for ECDSA private keys. One
+	// or more of `P-224`, `P-256`, `P-384` or `P-521`. Keys of other
+	// algorithms are not constrained.
+	// An omitted field permits any curve.
+	// +listType=set
+	// +optional
+	Curves []string `json:"curves,omitempty"`
+
+	// MinRSAPublicExponent defines the minimum public exponent of RSA
+	// private keys. Values are inclusive. Keys of other algorithms are not
+	// constrained.
+	// An omitted field applies no minimum constraint on the exponent.
+	// +optional
+	MinRSAPublicExponent *int `json:"minRSAPublicExponent,omitempty"`
+
+	// MaxRSAPublicExponent defines the maximum public exponent of RSA
+	// private keys. Values are inclusive; set MinRSAPublicExponent and
+	// MaxRSAPublicExponent to `65537` to require that exponent. Keys of
+	// other algorithms are not constrained.
+	// An omitted field applies no maximum constraint on the exponent.
+	// +optional
+	MaxRSAPublicExponent *int `json:"maxRSAPublicExponent,omitempty"`
+
+	// RejectWeakKeys rejects known weak public keys when `true`: RSA keys
+	// vulnerable to ROCA (CVE-2017-15361), RSA keys whose modulus has a
+	// small prime factor, and keys on the blocklists configured with the
+	// `--constraints-weak-key-blocklist-files` flag, such as the Debian
+	// weak keys (CVE-2008-0166).
+	// Defaults to `false`.
+	// +optional
+	RejectWeakKeys *bool `json:"rejectWeakKeys,omitempty"`
+}
+
+// CertificateRequestPolicyLimits defines the maximum rate at which
+// CertificateRequests are approved.
+// Limits are evaluated against the CertificateRequests approved by
+// approver-policy, by any policy, within the window. Limits are best-effort:
+// as approvals are counted from the informer cache, requests reviewed before
+// earlier approvals are observed may exceed a limit.
+type CertificateRequestPolicyLimits struct {
+	// Window is the duration over which approvals are counted, e.g. `1h`.
+	// An approval is counted from the time the request was approved.
+	Window metav1.Duration `json:"window"`
+
+	// MaxApprovalsPerNamespace defines the maximum number of requests that
+	// are approved in the namespace of a request within the window.
+	// An omitted field applies no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxApprovalsPerNamespace *int `json:"maxApprovalsPerNamespace,omitempty"`
+
+	// MaxApprovalsPerRequester defines the maximum number of requests that
+	// are approved for the user that created a request within the window.
+	// An omitted field applies no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxApprovalsPerRequester *int `json:"maxApprovalsPerRequester,omitempty"`
+
+	// MaxApprovalsPerDNSName defines the maximum number of requests that are
+	// approved for each of the DNS names of a request within the window.
+	// An omitted field applies no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxApprovalsPerDNSName *int `json:"maxApprovalsPerDNSName,omitempty"`
+
+	// MaxDNSNamesPerNamespace defines the maximum number of distinct DNS
+	// names in the requests that are approved in the namespace of a request
+	// within the window. A request for DNS names which have all been approved
+	// in the window is not limited.
+	// An omitted field applies no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDNSNamesPerNamespace *int `json:"maxDNSNamesPerNamespace,omitempty"`
 }
 
 // CertificateRequestPolicyPluginData is configuration needed by the plugin
@@ -355,6 +905,50 @@ type CertificateRequestPolicySelector struct {
 	// If this field is omitted, resources in all namespaces are checked.
 	// +optional
 	Namespace *CertificateRequestPolicySelectorNamespace `json:"namespace"`
+
+	// RequestLabels is used to match by the labels of the CertificateRequest
+	// itself, meaning the CertificateRequestPolicy will only match
+	// CertificateRequests whose labels match the selector.
+	// If this field is omitted, requests with any labels are matched.
+	// +optional
+	RequestLabels *metav1.LabelSelector `json:"requestLabels,omitempty"`
+
+	// RequestAnnotations is used to match by the annotations of the
+	// CertificateRequest itself, meaning the CertificateRequestPolicy will
+	// only match CertificateRequests whose annotations match the selector.
+	// Annotation values must be valid label values to be matched by `In` or
+	// `NotIn` requirements, or `matchLabels`.
+	// If this field is omitted, requests with any annotations are matched.
+	// +optional
+	RequestAnnotations *metav1.LabelSelector `json:"requestAnnotations,omitempty"`
+
+	// Requester is used to match by the identity of the user which created
+	// the CertificateRequest, meaning the CertificateRequestPolicy will only
+	// match CertificateRequests created by matching users.
+	// When Requester is defined, the policy does not need to be bound to the
+	// requesting user with RBAC, unless `requireRBACBinding` is true.
+	// Requester must define at least one of usernames, groups or
+	// serviceAccounts.
+	// If this field is omitted, the policy must be bound to the requesting
+	// user with RBAC.
+	// +optional
+	Requester *CertificateRequestPolicySelectorRequester `json:"requester,omitempty"`
+
+	// Expression is a Common Expression Language (CEL) expression which
+	// must evaluate to true for the CertificateRequestPolicy to match a
+	// CertificateRequest, in addition to the other selectors.
+	// The `cr` variable contains the request, see `validations` for its
+	// fields, and the `namespaceLabels` variable contains the labels of the
+	// namespace the request was created in. If the expression fails to
+	// evaluate, the request is neither approved nor denied, and is reviewed
+	// again later.
+	//
+	// Example (only match requests created by cert-manager Certificates):
+	// ```
+	// expression: "'cert-manager.io/certificate-name' in cr.annotations"
+	// ```
+	// +optional
+	Expression *string `json:"expression,omitempty"`
 }
 
 // CertificateRequestPolicySelectorIssuerRef defines the selector for matching
@@ -380,6 +974,11 @@ type CertificateRequestPolicySelectorIssuerRef struct {
 	// An omitted field matches all groups.
 	// +optional
 	Group *string `json:"group,omitempty"`
+
+	// MatchMode defines how wildcards in Name and Group are matched. One of
+	// `Glob` or `DNS`. Defaults to `Glob`.
+	// +optional
+	MatchMode CertificateRequestPolicyMatchMode `json:"matchMode,omitempty"`
 }
 
 // CertificateRequestPolicySelectorNamespace defines the selector for matching
@@ -398,14 +997,142 @@ type CertificateRequestPolicySelectorNamespace struct {
 	// selector.
 	// +optional
 	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// MatchExpressions is a list of Namespace label selector requirements
+	// that select on CertificateRequests which have been created in a
+	// namespace matching all of the requirements. Valid operators are `In`,
+	// `NotIn`, `Exists` and `DoesNotExist`.
+	// +listType=atomic
+	// +optional
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+
+	// ExcludeNames is the set of namespace names that CertificateRequests
+	// must _not_ have been created in to be selected, even if they are
+	// matched by the other namespace selectors.
+	// Accepts wildcards "*".
+	// +listType=set
+	// +optional
+	ExcludeNames []string `json:"excludeNames,omitempty"`
+}
+
+// CertificateRequestPolicySelectorRequester defines the selector for matching
+// the user which created requests. A request is matched if any of the
+// usernames, groups or service accounts match. If none are defined, requests
+// from all users are matched.
+type CertificateRequestPolicySelectorRequester struct {
+	// Usernames is the set of usernames that select on CertificateRequests
+	// created by a matching user.
+	// Accepts wildcards "*".
+	// +listType=set
+	// +optional
+	Usernames []string `json:"usernames,omitempty"`
+
+	// Groups is the set of groups that select on CertificateRequests created
+	// by a user which is a member of a matching group.
+	// Accepts wildcards "*".
+	// +listType=set
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// ServiceAccounts is the set of service accounts that select on
+	// CertificateRequests created by a matching service account.
+	// +listType=atomic
+	// +optional
+	ServiceAccounts []CertificateRequestPolicySelectorServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// RequireRBACBinding, if true, requires the policy to also be bound to
+	// the requesting user with RBAC for requests to be matched.
+	// Defaults to `false`.
+	// +optional
+	RequireRBACBinding *bool `json:"requireRBACBinding,omitempty"`
+}
+
+// CertificateRequestPolicySelectorServiceAccount defines the selector for
+// matching a service account.
+type CertificateRequestPolicySelectorServiceAccount struct {
+	// Namespace is the namespace of the service account.
+	// Accepts wildcards "*".
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the service account.
+	// Accepts wildcards "*".
+	Name string `json:"name"`
 }
 
+// CertificateRequestPolicyManualApproval defines the people who must approve
+// requests before they are approved by a CertificateRequestPolicy.
+type CertificateRequestPolicyManualApproval struct {
+	// RequiredApprovals is the number of distinct approvers that must vote to
+	// approve a request. The requester of a request may not vote on it, so
+	// that a value of 1 or more is a two-person rule.
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals int `json:"requiredApprovals"`
+
+	// Approvers are the users that may vote on requests. Votes by other users
+	// are ignored.
+	Approvers CertificateRequestPolicyManualApprovalApprovers `json:"approvers"`
+}
+
+// CertificateRequestPolicyManualApprovalApprovers defines the users that may
+// vote on requests. At least one of Users or Groups must be defined.
+type CertificateRequestPolicyManualApprovalApprovers struct {
+	// Users are the usernames of approvers. Accepts wildcards "*".
+	// +listType=set
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// Groups are the groups of approvers. A user in any of the groups is an
+	// approver. Accepts wildcards "*".
+	// +listType=set
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// CertificateRequestPolicySchedule defines the recurring windows of time in
+// which a CertificateRequestPolicy is active.
+type CertificateRequestPolicySchedule struct {
+	// TimeZone is the IANA name of the time zone the windows are defined in,
+	// e.g. `Europe/London`. Defaults to `UTC`.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Windows are the windows of time in which the policy is active. The
+	// policy is active if any window contains the current time.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Windows []CertificateRequestPolicyScheduleWindow `json:"windows"`
+}
+
+// CertificateRequestPolicyScheduleWindow defines a daily window of time in
+// which a CertificateRequestPolicy is active.
+type CertificateRequestPolicyScheduleWindow struct {
+	// Days are the days of the week the window starts on, e.g. `Monday`.
+	// An omitted field means the window starts on every day.
+	// +listType=set
+	// +optional
+	Days []CertificateRequestPolicyScheduleDay `json:"days,omitempty"`
+
+	// Start is the time of day the window starts at, in the format `HH:MM`.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window ends at, in the format `HH:MM`. An
+	// End which is not after Start ends the window on the following day, so
+	// that a window may span midnight.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// CertificateRequestPolicyScheduleDay is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type CertificateRequestPolicyScheduleDay string
+
 // CertificateRequestPolicyStatus defines the observed state of the
 // CertificateRequestPolicy.
 type CertificateRequestPolicyStatus struct {
 	// List of status conditions to indicate the status of the
 	// CertificateRequestPolicy.
-	// Known condition types are `Ready`.
+	// Known condition types are `Ready` and `Active`.
 	// +listType=map
 	// +listMapKey=type
 	// +optional
@@ -415,7 +1142,7 @@ type CertificateRequestPolicyStatus struct {
 // CertificateRequestPolicyCondition contains condition information for a
 // CertificateRequestPolicyStatus.
 type CertificateRequestPolicyCondition struct {
-	// Type of the condition, known values are (`Ready`).
+	// Type of the condition, known values are (`Ready`, `Active`).
 	Type CertificateRequestPolicyConditionType `json:"type"`
 
 	// Status of the condition, one of ('True', 'False', 'Unknown').
@@ -456,4 +1183,10 @@ const (
 	// evaluating CertificateRequests.
 	// +k8s:deepcopy-gen=false
 	CertificateRequestPolicyConditionReady CertificateRequestPolicyConditionType = "Ready"
+
+	// CertificateRequestPolicyConditionActive indicates whether the
+	// CertificateRequestPolicy is within the period and schedule in which it
+	// is evaluated against CertificateRequests.
+	// +k8s:deepcopy-gen=false
+	CertificateRequestPolicyConditionActive CertificateRequestPolicyConditionType = "Active"
 )
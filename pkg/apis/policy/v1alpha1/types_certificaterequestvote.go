@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var CertificateRequestVoteKind = "CertificateRequestVote"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Request",type="string",JSONPath=".spec.certificateRequestName",description="Name of the CertificateRequest voted on"
+// +kubebuilder:printcolumn:name="Decision",type="string",JSONPath=".spec.decision",description="Decision of the vote"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.username",description="User that cast the vote"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Timestamp CertificateRequestVote was created"
+//+kubebuilder:resource:categories=cert-manager,shortName=crv
+//+kubebuilder:subresource:status
+
+// CertificateRequestVote is a vote by a user to approve or deny a
+// CertificateRequest in the same namespace, which is pending manual approval
+// by a CertificateRequestPolicy. Who may vote is controlled by RBAC on
+// CertificateRequestVotes, and by the approvers of the policy.
+type CertificateRequestVote struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateRequestVoteSpec   `json:"spec,omitempty"`
+	Status CertificateRequestVoteStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// CertificateRequestVoteList is a list of CertificateRequestVotes.
+type CertificateRequestVoteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertificateRequestVote `json:"items"`
+}
+
+// CertificateRequestVoteSpec defines the vote. The spec is immutable.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type CertificateRequestVoteSpec struct {
+	// CertificateRequestName is the name of the CertificateRequest, in the
+	// namespace of the vote, that is voted on.
+	// +kubebuilder:validation:MinLength=1
+	CertificateRequestName string `json:"certificateRequestName"`
+
+	// CertificateRequestUID is the UID of the CertificateRequest that is
+	// voted on. Must be the UID of the named CertificateRequest when the vote
+	// is created, so that the vote never counts towards another request
+	// which is later created with the same name.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	CertificateRequestUID types.UID `json:"certificateRequestUID"`
+
+	// Decision is the decision of the vote. One of `Approve` or `Deny`.
+	Decision CertificateRequestVoteDecision `json:"decision"`
+
+	// Reason is an optional human readable reason for the decision, which is
+	// recorded in the event of the vote.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Username is the name of the user that casts the vote. Must be the user
+	// that creates the vote.
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+
+	// Groups are the groups of the user that casts the vote. Must be the
+	// groups of the user that creates the vote.
+	// +listType=atomic
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// CertificateRequestVoteDecision is the decision of a CertificateRequestVote.
+// +kubebuilder:validation:Enum=Approve;Deny
+type CertificateRequestVoteDecision string
+
+const (
+	// CertificateRequestVoteDecisionApprove is a vote to approve the
+	// CertificateRequest.
+	CertificateRequestVoteDecisionApprove CertificateRequestVoteDecision = "Approve"
+
+	// CertificateRequestVoteDecisionDeny is a vote to deny the
+	// CertificateRequest. A single deny vote by an approver denies the
+	// request, even if another policy would approve it.
+	CertificateRequestVoteDecisionDeny CertificateRequestVoteDecision = "Deny"
+)
+
+// CertificateRequestVoteStatus defines the observed state of the
+// CertificateRequestVote.
+type CertificateRequestVoteStatus struct {
+	// RecordedTime is the time the vote was recorded in an event on the
+	// CertificateRequest.
+	// +optional
+	RecordedTime *metav1.Time `json:"recordedTime,omitempty"`
+}
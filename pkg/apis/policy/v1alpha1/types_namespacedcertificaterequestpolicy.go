@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var NamespacedCertificateRequestPolicyKind = "NamespacedCertificateRequestPolicy"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//+kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type == "Ready")].status`,description="NamespacedCertificateRequestPolicy is ready for evaluation"
+// +kubebuilder:printcolumn:name="Active",type="string",JSONPath=`.status.conditions[?(@.type == "Active")].status`,description="NamespacedCertificateRequestPolicy is within its active period and schedule"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Timestamp NamespacedCertificateRequestPolicy was created"
+//+kubebuilder:resource:categories=cert-manager,shortName=ncrp,scope=Namespaced
+//+kubebuilder:subresource:status
+
+// NamespacedCertificateRequestPolicy is a namespaced variant of
+// CertificateRequestPolicy, which only ever applies to CertificateRequests
+// created in the same namespace as the policy.
+// NamespacedCertificateRequestPolicies can only further restrict which
+// requests are approved. A CertificateRequest must always be approved by a
+// CertificateRequestPolicy. If any NamespacedCertificateRequestPolicy in the
+// request's namespace is appropriate for the request, then the request must
+// also be approved by at least one of those NamespacedCertificateRequestPolicies.
+// NamespacedCertificateRequestPolicies apply to all requesters in the
+// namespace, and do not need to be bound with RBAC.
+// The `spec.selector.namespace` field must not be set.
+type NamespacedCertificateRequestPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateRequestPolicySpec   `json:"spec,omitempty"`
+	Status CertificateRequestPolicyStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// NamespacedCertificateRequestPolicyList is a list of
+// NamespacedCertificateRequestPolicies.
+type NamespacedCertificateRequestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedCertificateRequestPolicy `json:"items"`
+}
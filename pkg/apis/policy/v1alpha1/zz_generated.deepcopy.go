@@ -66,6 +66,11 @@ func (in *CertificateRequestPolicyAllowed) DeepCopyInto(out *CertificateRequestP
 		*out = new(CertificateRequestPolicyAllowedStringSlice)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSNameMatching != nil {
+		in, out := &in.DNSNameMatching, &out.DNSNameMatching
+		*out = new(CertificateRequestPolicyAllowedDNSNameMatching)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPAddresses != nil {
 		in, out := &in.IPAddresses, &out.IPAddresses
 		*out = new(CertificateRequestPolicyAllowedStringSlice)
@@ -73,7 +78,7 @@ func (in *CertificateRequestPolicyAllowed) DeepCopyInto(out *CertificateRequestP
 	}
 	if in.URIs != nil {
 		in, out := &in.URIs, &out.URIs
-		*out = new(CertificateRequestPolicyAllowedStringSlice)
+		*out = new(CertificateRequestPolicyAllowedURIs)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EmailAddresses != nil {
@@ -81,6 +86,13 @@ func (in *CertificateRequestPolicyAllowed) DeepCopyInto(out *CertificateRequestP
 		*out = new(CertificateRequestPolicyAllowedStringSlice)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OtherNames != nil {
+		in, out := &in.OtherNames, &out.OtherNames
+		*out = make([]CertificateRequestPolicyAllowedOtherName, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.IsCA != nil {
 		in, out := &in.IsCA, &out.IsCA
 		*out = new(bool)
@@ -100,6 +112,18 @@ func (in *CertificateRequestPolicyAllowed) DeepCopyInto(out *CertificateRequestP
 		*out = new(CertificateRequestPolicyAllowedX509Subject)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]CertificateRequestPolicyAllowedExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DenyUnknownExtensions != nil {
+		in, out := &in.DenyUnknownExtensions, &out.DenyUnknownExtensions
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowed.
@@ -112,6 +136,79 @@ func (in *CertificateRequestPolicyAllowed) DeepCopy() *CertificateRequestPolicyA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedDNSNameMatching) DeepCopyInto(out *CertificateRequestPolicyAllowedDNSNameMatching) {
+	*out = *in
+	if in.AllowWildcardNames != nil {
+		in, out := &in.AllowWildcardNames, &out.AllowWildcardNames
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedDNSNameMatching.
+func (in *CertificateRequestPolicyAllowedDNSNameMatching) DeepCopy() *CertificateRequestPolicyAllowedDNSNameMatching {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedDNSNameMatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedExtension) DeepCopyInto(out *CertificateRequestPolicyAllowedExtension) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Critical != nil {
+		in, out := &in.Critical, &out.Critical
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedExtension.
+func (in *CertificateRequestPolicyAllowedExtension) DeepCopy() *CertificateRequestPolicyAllowedExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedOtherName) DeepCopyInto(out *CertificateRequestPolicyAllowedOtherName) {
+	*out = *in
+	in.CertificateRequestPolicyAllowedStringSlice.DeepCopyInto(&out.CertificateRequestPolicyAllowedStringSlice)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedOtherName.
+func (in *CertificateRequestPolicyAllowedOtherName) DeepCopy() *CertificateRequestPolicyAllowedOtherName {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedOtherName)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicyAllowedString) DeepCopyInto(out *CertificateRequestPolicyAllowedString) {
 	*out = *in
@@ -180,6 +277,90 @@ func (in *CertificateRequestPolicyAllowedStringSlice) DeepCopy() *CertificateReq
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedSubjectAttribute) DeepCopyInto(out *CertificateRequestPolicyAllowedSubjectAttribute) {
+	*out = *in
+	in.CertificateRequestPolicyAllowedStringSlice.DeepCopyInto(&out.CertificateRequestPolicyAllowedStringSlice)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedSubjectAttribute.
+func (in *CertificateRequestPolicyAllowedSubjectAttribute) DeepCopy() *CertificateRequestPolicyAllowedSubjectAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedSubjectAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedURIMatcher) DeepCopyInto(out *CertificateRequestPolicyAllowedURIMatcher) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowQuery != nil {
+		in, out := &in.AllowQuery, &out.AllowQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowFragment != nil {
+		in, out := &in.AllowFragment, &out.AllowFragment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowUserinfo != nil {
+		in, out := &in.AllowUserinfo, &out.AllowUserinfo
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedURIMatcher.
+func (in *CertificateRequestPolicyAllowedURIMatcher) DeepCopy() *CertificateRequestPolicyAllowedURIMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedURIMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedURIs) DeepCopyInto(out *CertificateRequestPolicyAllowedURIs) {
+	*out = *in
+	in.CertificateRequestPolicyAllowedStringSlice.DeepCopyInto(&out.CertificateRequestPolicyAllowedStringSlice)
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]CertificateRequestPolicyAllowedURIMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedURIs.
+func (in *CertificateRequestPolicyAllowedURIs) DeepCopy() *CertificateRequestPolicyAllowedURIs {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedURIs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicyAllowedX509Subject) DeepCopyInto(out *CertificateRequestPolicyAllowedX509Subject) {
 	*out = *in
@@ -223,6 +404,38 @@ func (in *CertificateRequestPolicyAllowedX509Subject) DeepCopyInto(out *Certific
 		*out = new(CertificateRequestPolicyAllowedString)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DomainComponents != nil {
+		in, out := &in.DomainComponents, &out.DomainComponents
+		*out = new(CertificateRequestPolicyAllowedStringSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = new(CertificateRequestPolicyAllowedStringSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Titles != nil {
+		in, out := &in.Titles, &out.Titles
+		*out = new(CertificateRequestPolicyAllowedStringSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = new(CertificateRequestPolicyAllowedStringSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make([]CertificateRequestPolicyAllowedSubjectAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DenyUnknownAttributes != nil {
+		in, out := &in.DenyUnknownAttributes, &out.DenyUnknownAttributes
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedX509Subject.
@@ -272,6 +485,16 @@ func (in *CertificateRequestPolicyConstraints) DeepCopyInto(out *CertificateRequ
 		*out = new(CertificateRequestPolicyConstraintsPrivateKey)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SignatureAlgorithm != nil {
+		in, out := &in.SignatureAlgorithm, &out.SignatureAlgorithm
+		*out = new(CertificateRequestPolicyConstraintsSignatureAlgorithm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicKeyReuse != nil {
+		in, out := &in.PublicKeyReuse, &out.PublicKeyReuse
+		*out = new(CertificateRequestPolicyConstraintsPublicKeyReuse)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyConstraints.
@@ -302,6 +525,26 @@ func (in *CertificateRequestPolicyConstraintsPrivateKey) DeepCopyInto(out *Certi
 		*out = new(int)
 		**out = **in
 	}
+	if in.Curves != nil {
+		in, out := &in.Curves, &out.Curves
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinRSAPublicExponent != nil {
+		in, out := &in.MinRSAPublicExponent, &out.MinRSAPublicExponent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRSAPublicExponent != nil {
+		in, out := &in.MaxRSAPublicExponent, &out.MaxRSAPublicExponent
+		*out = new(int)
+		**out = **in
+	}
+	if in.RejectWeakKeys != nil {
+		in, out := &in.RejectWeakKeys, &out.RejectWeakKeys
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyConstraintsPrivateKey.
@@ -314,6 +557,92 @@ func (in *CertificateRequestPolicyConstraintsPrivateKey) DeepCopy() *Certificate
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyConstraintsPublicKeyReuse) DeepCopyInto(out *CertificateRequestPolicyConstraintsPublicKeyReuse) {
+	*out = *in
+	if in.DenyAcrossNamespaces != nil {
+		in, out := &in.DenyAcrossNamespaces, &out.DenyAcrossNamespaces
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DenyWithinCertificate != nil {
+		in, out := &in.DenyWithinCertificate, &out.DenyWithinCertificate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyConstraintsPublicKeyReuse.
+func (in *CertificateRequestPolicyConstraintsPublicKeyReuse) DeepCopy() *CertificateRequestPolicyConstraintsPublicKeyReuse {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyConstraintsPublicKeyReuse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyConstraintsSignatureAlgorithm) DeepCopyInto(out *CertificateRequestPolicyConstraintsSignatureAlgorithm) {
+	*out = *in
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Forbidden != nil {
+		in, out := &in.Forbidden, &out.Forbidden
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyConstraintsSignatureAlgorithm.
+func (in *CertificateRequestPolicyConstraintsSignatureAlgorithm) DeepCopy() *CertificateRequestPolicyConstraintsSignatureAlgorithm {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyConstraintsSignatureAlgorithm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyLimits) DeepCopyInto(out *CertificateRequestPolicyLimits) {
+	*out = *in
+	out.Window = in.Window
+	if in.MaxApprovalsPerNamespace != nil {
+		in, out := &in.MaxApprovalsPerNamespace, &out.MaxApprovalsPerNamespace
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxApprovalsPerRequester != nil {
+		in, out := &in.MaxApprovalsPerRequester, &out.MaxApprovalsPerRequester
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxApprovalsPerDNSName != nil {
+		in, out := &in.MaxApprovalsPerDNSName, &out.MaxApprovalsPerDNSName
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxDNSNamesPerNamespace != nil {
+		in, out := &in.MaxDNSNamesPerNamespace, &out.MaxDNSNamesPerNamespace
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyLimits.
+func (in *CertificateRequestPolicyLimits) DeepCopy() *CertificateRequestPolicyLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicyList) DeepCopyInto(out *CertificateRequestPolicyList) {
 	*out = *in
@@ -346,6 +675,47 @@ func (in *CertificateRequestPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyManualApproval) DeepCopyInto(out *CertificateRequestPolicyManualApproval) {
+	*out = *in
+	in.Approvers.DeepCopyInto(&out.Approvers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyManualApproval.
+func (in *CertificateRequestPolicyManualApproval) DeepCopy() *CertificateRequestPolicyManualApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyManualApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyManualApprovalApprovers) DeepCopyInto(out *CertificateRequestPolicyManualApprovalApprovers) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyManualApprovalApprovers.
+func (in *CertificateRequestPolicyManualApprovalApprovers) DeepCopy() *CertificateRequestPolicyManualApprovalApprovers {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyManualApprovalApprovers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicyPluginData) DeepCopyInto(out *CertificateRequestPolicyPluginData) {
 	*out = *in
@@ -368,6 +738,53 @@ func (in *CertificateRequestPolicyPluginData) DeepCopy() *CertificateRequestPoli
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicySchedule) DeepCopyInto(out *CertificateRequestPolicySchedule) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CertificateRequestPolicyScheduleWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySchedule.
+func (in *CertificateRequestPolicySchedule) DeepCopy() *CertificateRequestPolicySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyScheduleWindow) DeepCopyInto(out *CertificateRequestPolicyScheduleWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]CertificateRequestPolicyScheduleDay, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyScheduleWindow.
+func (in *CertificateRequestPolicyScheduleWindow) DeepCopy() *CertificateRequestPolicyScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicySelector) DeepCopyInto(out *CertificateRequestPolicySelector) {
 	*out = *in
@@ -381,6 +798,26 @@ func (in *CertificateRequestPolicySelector) DeepCopyInto(out *CertificateRequest
 		*out = new(CertificateRequestPolicySelectorNamespace)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestLabels != nil {
+		in, out := &in.RequestLabels, &out.RequestLabels
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestAnnotations != nil {
+		in, out := &in.RequestAnnotations, &out.RequestAnnotations
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Requester != nil {
+		in, out := &in.Requester, &out.Requester
+		*out = new(CertificateRequestPolicySelectorRequester)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySelector.
@@ -438,6 +875,18 @@ func (in *CertificateRequestPolicySelectorNamespace) DeepCopyInto(out *Certifica
 			(*out)[key] = val
 		}
 	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]metav1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludeNames != nil {
+		in, out := &in.ExcludeNames, &out.ExcludeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySelectorNamespace.
@@ -450,9 +899,72 @@ func (in *CertificateRequestPolicySelectorNamespace) DeepCopy() *CertificateRequ
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicySelectorRequester) DeepCopyInto(out *CertificateRequestPolicySelectorRequester) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]CertificateRequestPolicySelectorServiceAccount, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequireRBACBinding != nil {
+		in, out := &in.RequireRBACBinding, &out.RequireRBACBinding
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySelectorRequester.
+func (in *CertificateRequestPolicySelectorRequester) DeepCopy() *CertificateRequestPolicySelectorRequester {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicySelectorRequester)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicySelectorServiceAccount) DeepCopyInto(out *CertificateRequestPolicySelectorServiceAccount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySelectorServiceAccount.
+func (in *CertificateRequestPolicySelectorServiceAccount) DeepCopy() *CertificateRequestPolicySelectorServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicySelectorServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestPolicySpec) DeepCopyInto(out *CertificateRequestPolicySpec) {
 	*out = *in
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(CertificateRequestPolicySchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Allowed != nil {
 		in, out := &in.Allowed, &out.Allowed
 		*out = new(CertificateRequestPolicyAllowed)
@@ -463,6 +975,23 @@ func (in *CertificateRequestPolicySpec) DeepCopyInto(out *CertificateRequestPoli
 		*out = new(CertificateRequestPolicyConstraints)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(CertificateRequestPolicyLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManualApproval != nil {
+		in, out := &in.ManualApproval, &out.ManualApproval
+		*out = new(CertificateRequestPolicyManualApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Plugins != nil {
 		in, out := &in.Plugins, &out.Plugins
 		*out = make(map[string]CertificateRequestPolicyPluginData, len(*in))
@@ -505,6 +1034,163 @@ func (in *CertificateRequestPolicyStatus) DeepCopy() *CertificateRequestPolicySt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestVote) DeepCopyInto(out *CertificateRequestVote) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestVote.
+func (in *CertificateRequestVote) DeepCopy() *CertificateRequestVote {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestVote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequestVote) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestVoteList) DeepCopyInto(out *CertificateRequestVoteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateRequestVote, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestVoteList.
+func (in *CertificateRequestVoteList) DeepCopy() *CertificateRequestVoteList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestVoteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequestVoteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestVoteSpec) DeepCopyInto(out *CertificateRequestVoteSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestVoteSpec.
+func (in *CertificateRequestVoteSpec) DeepCopy() *CertificateRequestVoteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestVoteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestVoteStatus) DeepCopyInto(out *CertificateRequestVoteStatus) {
+	*out = *in
+	if in.RecordedTime != nil {
+		in, out := &in.RecordedTime, &out.RecordedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestVoteStatus.
+func (in *CertificateRequestVoteStatus) DeepCopy() *CertificateRequestVoteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestVoteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCertificateRequestPolicy) DeepCopyInto(out *NamespacedCertificateRequestPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCertificateRequestPolicy.
+func (in *NamespacedCertificateRequestPolicy) DeepCopy() *NamespacedCertificateRequestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCertificateRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCertificateRequestPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCertificateRequestPolicyList) DeepCopyInto(out *NamespacedCertificateRequestPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedCertificateRequestPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCertificateRequestPolicyList.
+func (in *NamespacedCertificateRequestPolicyList) DeepCopy() *NamespacedCertificateRequestPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCertificateRequestPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCertificateRequestPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidationRule) DeepCopyInto(out *ValidationRule) {
 	*out = *in
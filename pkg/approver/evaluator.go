@@ -23,6 +23,7 @@ package approver
 
 import (
 	"context"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 
@@ -51,6 +52,25 @@ type EvaluationResponse struct {
 	// Message is optional context as to why the evaluator has given the result
 	// it has.
 	Message string
+
+	// RequeueAfter, if non-zero on a ResultNotDenied response, asks that the
+	// request is neither approved nor denied by this policy for now, and is
+	// reviewed again after the duration. For example, because approving the
+	// request would exceed a rate limit.
+	RequeueAfter time.Duration
+
+	// Pending, if true on a ResultNotDenied response, asks that the request
+	// is neither approved nor denied by this policy until something outside of
+	// the request changes, for example a person approving it. The request is
+	// reviewed again when the evaluator causes it to be, and after
+	// RequeueAfter if non-zero.
+	Pending bool
+
+	// Terminal, if true on a ResultDenied response, asks that the request is
+	// denied outright, rather than only not being approved by this policy. No
+	// other policy may approve the request, for example because a person has
+	// denied it.
+	Terminal bool
 }
 
 // Evaluator is responsible for making decisions on whether a
@@ -68,5 +88,7 @@ type Evaluator interface {
 	// An error should only be returned if there was an error in the evaluator
 	// attempting to evaluate the request over the policy itself. A policy
 	// manager may re-evaluate an evaluation if an error is returned.
+	// NamespacedCertificateRequestPolicies are given as a
+	// CertificateRequestPolicy with their namespace set.
 	Evaluate(context.Context, *policyapi.CertificateRequestPolicy, *cmapi.CertificateRequest) (EvaluationResponse, error)
 }
@@ -18,6 +18,7 @@ package manager
 
 import (
 	"context"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
@@ -49,6 +50,40 @@ type ReviewResponse struct {
 	// Message is optional context as to why the manager has given the result it
 	// has.
 	Message string
+
+	// RequeueAfter, if non-zero on a ResultUnprocessed response, is the
+	// duration after which the request should be reviewed again, because the
+	// policy which would approve it has asked for it to be delayed.
+	RequeueAfter time.Duration
+
+	// Pending, if true on a ResultUnprocessed response, means the policy which
+	// would approve the request is waiting for something outside of the
+	// request, such as manual approval, before it approves it.
+	Pending bool
+
+	// Audits are the decisions that Audit CertificateRequestPolicies would
+	// have made on the request, had they been enforced. Audits never affect
+	// the Result of the review.
+	Audits []AuditResponse
+}
+
+// AuditResponse is the decision an Audit CertificateRequestPolicy would have
+// made on a request.
+type AuditResponse struct {
+	// Kind is the kind of the policy, either CertificateRequestPolicy or
+	// NamespacedCertificateRequestPolicy.
+	Kind string
+
+	// Name is the name of the policy.
+	Name string
+
+	// Result is the result the policy would have given, either ResultApproved
+	// or ResultDenied.
+	Result ReviewResult
+
+	// Message is optional context as to why the policy would have given the
+	// result it has.
+	Message string
 }
 
 // Interface is an Approver Manager that responsible for evaluating whether
@@ -65,6 +100,9 @@ type Interface interface {
 	// - Consumers should consider a ResultUnprocessed response to mean the
 	//   manager doesn't consider the request to be appropriate for any evaluator
 	//   and so no review was run. The request is neither approved or denied.
+	//   If RequeueAfter is set, the request should be reviewed again after
+	//   that duration. If Pending is set, the request is waiting to be
+	//   approved.
 	// - Consumers should treat any error response as marking the
 	//   CertificateRequest as neither approved nor denied, and may consider
 	//   re-evaluation at a later time.
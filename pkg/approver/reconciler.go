@@ -55,11 +55,14 @@ type Reconciler interface {
 	// to this Reconciler.
 	// A returned error means that there was an error when trying to evaluate the
 	// Ready state. A returned error will have Ready be retried.
+	// NamespacedCertificateRequestPolicies are given as a
+	// CertificateRequestPolicy with their namespace set.
 	Ready(context.Context, *policyapi.CertificateRequestPolicy) (ReconcilerReadyResponse, error)
 
 	// EnqueueChan returns a channel that when a message is received, will
 	// reconcile the CertificateRequestPolicy with the given name, regardless of
-	// state.
+	// state. NamespacedCertificateRequestPolicies are reconciled by sending
+	// their "namespace/name" key.
 	// Useful for Reconcilers to provide an enqueue channel that forces a re-sync
 	// of CertificateRequestPolicies where external state (e.g. files, incoming
 	// events) effect the ready condition.
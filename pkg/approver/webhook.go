@@ -49,6 +49,8 @@ type Webhook interface {
 	// response with Allowed set to false, the object will not be committed.
 	// Similarly, any error will cause the object not to be committed
 	// immediately, and no other webhooks will be run.
+	// NamespacedCertificateRequestPolicies are given as a
+	// CertificateRequestPolicy with their namespace set.
 	Validate(context.Context, *policyapi.CertificateRequestPolicy) (WebhookValidationResponse, error)
 	// TODO: add a Name() method
 }
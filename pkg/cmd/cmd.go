@@ -26,6 +26,9 @@ import (
 
 	_ "github.com/cert-manager/approver-policy/pkg/internal/approver/allowed"
 	_ "github.com/cert-manager/approver-policy/pkg/internal/approver/constraints"
+	_ "github.com/cert-manager/approver-policy/pkg/internal/approver/keyreuse"
+	_ "github.com/cert-manager/approver-policy/pkg/internal/approver/limits"
+	_ "github.com/cert-manager/approver-policy/pkg/internal/approver/manualapproval"
 )
 
 // ExecutePolicyApprover executes the main approver-policy program making use
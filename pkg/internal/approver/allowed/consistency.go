@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// requestPath is the path of the PEM encoded CSR in the CertificateRequest.
+	requestPath = field.NewPath("spec", "request")
+
+	// usagesPath is the path of the usages in the CertificateRequest.
+	usagesPath = field.NewPath("spec", "usages")
+)
+
+// requestConsistency checks that the BasicConstraints, KeyUsage and
+// ExtKeyUsage extensions requested by the CSR neither contradict nor exceed
+// the isCA and usages of the CertificateRequest spec. The policy is evaluated
+// against the spec, but some issuers honour the extensions of the CSR.
+func (e evaluator) requestConsistency() field.ErrorList {
+	var el field.ErrorList
+
+	specKU, specEKU, err := utilpki.KeyUsagesForCertificateOrCertificateRequest(e.request.Spec.Usages, e.request.Spec.IsCA)
+	if err != nil {
+		return field.ErrorList{field.Invalid(usagesPath, e.request.Spec.Usages, err.Error())}
+	}
+
+	for _, ext := range e.csr.Extensions {
+		switch {
+		case ext.Id.Equal(utilpki.OIDExtensionBasicConstraints):
+			isCA, _, err := utilpki.UnmarshalBasicConstraints(ext.Value)
+			if err != nil {
+				el = append(el, field.Invalid(requestPath, "BasicConstraints", err.Error()))
+				continue
+			}
+			if isCA != e.request.Spec.IsCA {
+				el = append(el, field.Forbidden(requestPath,
+					fmt.Sprintf("CSR BasicConstraints CA:%t contradicts spec.isCA: %t", isCA, e.request.Spec.IsCA)))
+			}
+
+		case ext.Id.Equal(utilpki.OIDExtensionKeyUsage):
+			ku, err := utilpki.UnmarshalKeyUsage(ext.Value)
+			if err != nil {
+				el = append(el, field.Invalid(requestPath, "KeyUsage", err.Error()))
+				continue
+			}
+			if exceeded := ku &^ specKU; exceeded != 0 {
+				var usages []string
+				for _, usage := range apiutil.KeyUsageStrings(exceeded) {
+					usages = append(usages, string(usage))
+				}
+				el = append(el, field.Forbidden(requestPath,
+					fmt.Sprintf("CSR KeyUsage exceeds spec.usages: %s", strings.Join(usages, ", "))))
+			}
+
+		case ext.Id.Equal(utilpki.OIDExtensionExtendedKeyUsage):
+			ekus, unknown, err := utilpki.UnmarshalExtKeyUsage(ext.Value)
+			if err != nil {
+				el = append(el, field.Invalid(requestPath, "ExtKeyUsage", err.Error()))
+				continue
+			}
+
+			// The "any" usage permits every extended key usage.
+			if slices.Contains(specEKU, x509.ExtKeyUsageAny) {
+				continue
+			}
+
+			var usages []string
+			for _, eku := range ekus {
+				if !slices.Contains(specEKU, eku) {
+					usages = append(usages, string(apiutil.ExtKeyUsageStrings([]x509.ExtKeyUsage{eku})[0]))
+				}
+			}
+			for _, oid := range unknown {
+				usages = append(usages, oid.String())
+			}
+			if len(usages) > 0 {
+				el = append(el, field.Forbidden(requestPath,
+					fmt.Sprintf("CSR ExtKeyUsage exceeds spec.usages: %s", strings.Join(usages, ", "))))
+			}
+		}
+	}
+
+	return el
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test_requestConsistency(t *testing.T) {
+	withExtension := func(ext pkix.Extension, err error) gen.CSRModifier {
+		return func(csr *x509.CertificateRequest) error {
+			if err != nil {
+				return err
+			}
+			csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+			return nil
+		}
+	}
+
+	tests := map[string]struct {
+		csrMods []gen.CSRModifier
+		isCA    bool
+		usages  []cmapi.KeyUsage
+		expErrs field.ErrorList
+	}{
+		"if CSR has no extensions, return no errors": {
+			isCA:    true,
+			usages:  []cmapi.KeyUsage{cmapi.UsageServerAuth},
+			expErrs: nil,
+		},
+		"if CSR BasicConstraints matches spec isCA, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalBasicConstraints(true, nil))},
+			isCA:    true,
+			expErrs: nil,
+		},
+		"if CSR BasicConstraints requests CA but spec isCA is false, return error": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalBasicConstraints(true, nil))},
+			isCA:    false,
+			expErrs: field.ErrorList{field.Forbidden(requestPath, "CSR BasicConstraints CA:true contradicts spec.isCA: false")},
+		},
+		"if CSR BasicConstraints requests no CA but spec isCA is true, return error": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalBasicConstraints(false, nil))},
+			isCA:    true,
+			expErrs: field.ErrorList{field.Forbidden(requestPath, "CSR BasicConstraints CA:false contradicts spec.isCA: true")},
+		},
+		"if CSR KeyUsage is within the default usages, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalKeyUsage(x509.KeyUsageDigitalSignature))},
+			expErrs: nil,
+		},
+		"if CSR KeyUsage requests cert sign and spec isCA is true, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalKeyUsage(x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature))},
+			isCA:    true,
+			expErrs: nil,
+		},
+		"if CSR KeyUsage exceeds spec usages, return error": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalKeyUsage(x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign))},
+			usages:  []cmapi.KeyUsage{cmapi.UsageDigitalSignature},
+			expErrs: field.ErrorList{field.Forbidden(requestPath, "CSR KeyUsage exceeds spec.usages: cert sign, crl sign")},
+		},
+		"if CSR ExtKeyUsage is within spec usages, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalExtKeyUsage([]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, nil))},
+			usages:  []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth, cmapi.UsageClientAuth},
+			expErrs: nil,
+		},
+		"if CSR ExtKeyUsage exceeds spec usages, return error": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalExtKeyUsage(
+				[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageCodeSigning},
+				[]asn1.ObjectIdentifier{{1, 2, 3, 4}},
+			))},
+			usages:  []cmapi.KeyUsage{cmapi.UsageServerAuth},
+			expErrs: field.ErrorList{field.Forbidden(requestPath, "CSR ExtKeyUsage exceeds spec.usages: code signing, 1.2.3.4")},
+		},
+		"if spec usages include any, CSR ExtKeyUsage may request any usage": {
+			csrMods: []gen.CSRModifier{withExtension(utilpki.MarshalExtKeyUsage(
+				[]x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+				[]asn1.ObjectIdentifier{{1, 2, 3, 4}},
+			))},
+			usages:  []cmapi.KeyUsage{cmapi.UsageAny},
+			expErrs: nil,
+		},
+		"if CSR extension is malformed, return error": {
+			csrMods: []gen.CSRModifier{withExtension(pkix.Extension{Id: utilpki.OIDExtensionBasicConstraints, Value: []byte("foo")}, nil)},
+			expErrs: field.ErrorList{field.Invalid(requestPath, "BasicConstraints", "")},
+		},
+		"if spec usages are unknown, return error": {
+			usages:  []cmapi.KeyUsage{"foo"},
+			expErrs: field.ErrorList{field.Invalid(usagesPath, []cmapi.KeyUsage{"foo"}, "unknown key usages: [foo]")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			csr, err := utilpki.DecodeX509CertificateRequestBytes(csrFrom(t, test.csrMods...))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := evaluator{
+				request: gen.CertificateRequest("",
+					gen.SetCertificateRequestIsCA(test.isCA),
+					gen.SetCertificateRequestKeyUsages(test.usages...),
+				),
+				csr: csr,
+			}
+			errs := e.requestConsistency()
+
+			// Ignore the details of decoding errors, which come from encoding/asn1.
+			for _, err := range errs {
+				if err.Type == field.ErrorTypeInvalid && err.Field == requestPath.String() {
+					err.Detail = ""
+				}
+			}
+			assert.Equal(t, test.expErrs, errs)
+		})
+	}
+}
@@ -31,14 +31,20 @@ import (
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
 	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 // Evaluate evaluates whether the given CertificateRequest conforms to the
-// allowed attributes defined in the policy. The request _must_ conform to
-// _all_ allowed attributes in the policy to be permitted by the passed policy.
+// allowed attributes and validations defined in the policy. The request _must_
+// conform to _all_ allowed attributes and validations in the policy to be
+// permitted by the passed policy. The request is also denied if the
+// extensions of its CSR contradict or exceed its isCA and usages.
 // If the request is denied by the allowed attributes an explanation is
 // returned.
+// For Deny policies, the request is instead evaluated as to whether it matches
+// the allowed attributes, see matchDeny.
+// A request whose CSR can't be decoded is denied, or matched by Deny policies.
 // An error signals that the policy couldn't be evaluated to completion.
 func (a allowed) Evaluate(_ context.Context, policy *policyapi.CertificateRequestPolicy, request *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
 	var (
@@ -54,43 +60,57 @@ func (a allowed) Evaluate(_ context.Context, policy *policyapi.CertificateReques
 		allowed = new(policyapi.CertificateRequestPolicyAllowed)
 	}
 
+	deny := policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny
+
 	csr, err := utilpki.DecodeX509CertificateRequestBytes(request.Spec.Request)
+	if err != nil {
+		return invalidRequest(field.Invalid(requestPath, "CSR", err.Error()), deny), nil
+	}
+
+	otherNames, err := requestOtherNames(csr)
+	if err != nil {
+		return invalidRequest(field.Invalid(requestPath, "SubjectAltName", err.Error()), deny), nil
+	}
+
+	// The CEL representation of the request is built once, rather than by
+	// every validator it is passed to.
+	cr, err := validation.NewCertificateRequest(request)
 	if err != nil {
 		return approver.EvaluationResponse{}, err
 	}
 
 	evaluate := evaluator{
-		a:       a,
-		request: request,
-		csr:     csr,
-		allowed: allowed,
-		fldPath: fldPath,
-	}
-	evaluateSubject := evaluate.Subject()
-
-	evaluateFns := []func() field.ErrorList{
-		evaluate.CommonName,
-		evaluate.DNSNames,
-		evaluate.IPAddresses,
-		evaluate.URIs,
-		evaluate.EmailAddresses,
-		evaluate.IsCA,
-		evaluate.Usages,
-		evaluateSubject.Organization,
-		evaluateSubject.Country,
-		evaluateSubject.OrganizationalUnit,
-		evaluateSubject.Locality,
-		evaluateSubject.Province,
-		evaluateSubject.StreetAddress,
-		evaluateSubject.PostalCode,
-		evaluateSubject.SerialNumber,
-	}
-	for _, fn := range evaluateFns {
-		if e := fn(); e != nil {
+		a:           a,
+		request:     request,
+		csr:         csr,
+		cr:          cr,
+		otherNames:  otherNames,
+		allowed:     allowed,
+		validations: policy.Spec.Validations,
+		fldPath:     fldPath,
+		deny:        deny,
+	}
+
+	if evaluate.deny {
+		return evaluate.matchDeny(), nil
+	}
+
+	for _, attr := range evaluate.attributes() {
+		if e := attr.evaluate(attr.values); e != nil {
 			el = append(el, e...)
 		}
 	}
 
+	for i, v := range evaluate.validations {
+		if e := evaluate.validation(i, v); e != nil {
+			el = append(el, e)
+		}
+	}
+
+	// The policy is evaluated against the spec of the request, so the
+	// extensions of the CSR must agree with it.
+	el = append(el, evaluate.requestConsistency()...)
+
 	// If there are errors, then return not approved and the aggregated errors
 	if len(el) > 0 {
 		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error()}, nil
@@ -100,62 +120,260 @@ func (a allowed) Evaluate(_ context.Context, policy *policyapi.CertificateReques
 	return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
 }
 
-type evaluator struct {
-	a       allowed
-	request *cmapi.CertificateRequest
-	csr     *x509.CertificateRequest
-	allowed *policyapi.CertificateRequestPolicyAllowed
+// invalidRequest returns the response to a request whose CSR can't be decoded.
+// Allow policies deny the request. Deny policies match it, so that a malformed
+// CSR can't be used to avoid them.
+func invalidRequest(err *field.Error, deny bool) approver.EvaluationResponse {
+	if deny {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: err.Error() + " (failed to evaluate)"}
+	}
+	return approver.EvaluationResponse{Result: approver.ResultDenied, Message: field.ErrorList{err}.ToAggregate().Error()}
+}
+
+// matchDeny evaluates whether the request matches the allowed attributes of a
+// Deny policy. Every attribute which is defined by the policy must be matched
+// by at least one of the requested values of that attribute, and every
+// validation of the policy must pass, for the request to match. A matching
+// request is returned as NotDenied, so that the request will be denied by the
+// Deny policy.
+// An attribute or validation which fails to evaluate, such as a CEL rule
+// which errors at runtime, is taken as matched, so that a request can't avoid
+// a Deny policy by making its evaluation fail.
+func (e evaluator) matchDeny() approver.EvaluationResponse {
+	var (
+		el      field.ErrorList
+		matches []string
+	)
+
+	for _, attr := range e.attributes() {
+		if !attr.defined {
+			continue
+		}
+
+		var matched []string
+		for _, value := range attr.values {
+			if denyMatches(attr.evaluate([]string{value})) {
+				matched = append(matched, value)
+			}
+		}
+
+		if len(matched) == 0 {
+			el = append(el, field.NotFound(attr.fldPath, attr.values))
+			continue
+		}
+
+		matches = append(matches, fmt.Sprintf("%s: %s", attr.fldPath, strings.Join(matched, ", ")))
+	}
+
+	for i, v := range e.validations {
+		err := e.validation(i, v)
+		if err != nil && !denyMatches(field.ErrorList{err}) {
+			el = append(el, err)
+			continue
+		}
+
+		match := fmt.Sprintf("%s: %s", validationsPath.Index(i), v.Rule)
+		if err != nil {
+			match += " (failed to evaluate)"
+		}
+		matches = append(matches, match)
+	}
+
+	if len(el) > 0 {
+		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error()}
+	}
+
+	return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: strings.Join(matches, ", ")}
+}
+
+// denyMatches returns whether the errors of evaluating a request value against
+// a Deny policy still match it. Every error must be a failure to evaluate the
+// policy, rather than the value not meeting it.
+func denyMatches(el field.ErrorList) bool {
+	for _, err := range el {
+		if err.Type != field.ErrorTypeInternal {
+			return false
+		}
+	}
+	return true
+}
+
+// attribute is a single attribute of a request that is evaluated against the
+// allowed attributes of a policy.
+type attribute struct {
+	// fldPath is the path of the allowed attribute in the policy.
 	fldPath *field.Path
+
+	// values are the values of the attribute in the request.
+	values []string
+
+	// defined is true if the policy defines what values of the attribute are
+	// allowed.
+	defined bool
+
+	// evaluate evaluates the given request values of the attribute against the
+	// policy.
+	evaluate func(values []string) field.ErrorList
 }
 
-func (e evaluator) CommonName() field.ErrorList {
-	return e.a.evaluateString(e.request, e.csr.Subject.CommonName, e.allowed.CommonName, e.fldPath.Child("commonName"))
+// validationsPath is the path of the top-level validations in the policy.
+var validationsPath = field.NewPath("spec", "validations")
+
+type evaluator struct {
+	a           allowed
+	request     *cmapi.CertificateRequest
+	csr         *x509.CertificateRequest
+	cr          *validation.CertificateRequest
+	otherNames  []otherName
+	allowed     *policyapi.CertificateRequestPolicyAllowed
+	validations []policyapi.ValidationRule
+	fldPath     *field.Path
+
+	// deny is true if the policy is a Deny policy, whose allowed attributes
+	// are matched rather than enforced.
+	deny bool
 }
 
-func (e evaluator) DNSNames() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.csr.DNSNames, e.allowed.DNSNames, e.fldPath.Child("dnsNames"))
+// validation evaluates the i'th top-level validation rule of the policy against
+// the whole request. Returns nil if the rule passed.
+func (e evaluator) validation(i int, v policyapi.ValidationRule) *field.Error {
+	fldPath := validationsPath.Index(i)
+
+	validator, err := e.a.validators.GetRequest(v.Rule)
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	valid, err := validator.Validate(e.cr)
+	if err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if !valid {
+		return field.Forbidden(fldPath, ptr.Deref(v.Message, fmt.Sprintf("failed rule: %s", v.Rule)))
+	}
+	return nil
 }
 
-func (e evaluator) IPAddresses() field.ErrorList {
+// attributes returns all attributes of the request that are evaluated by the
+// allowed approver.
+func (e evaluator) attributes() []attribute {
 	var ips []string
 	for _, ip := range e.csr.IPAddresses {
 		ips = append(ips, ip.String())
 	}
-	return e.a.evaluateSlice(e.request, ips, e.allowed.IPAddresses, e.fldPath.Child("ipAddresses"))
-}
 
-func (e evaluator) URIs() field.ErrorList {
 	var uris []string
 	for _, uri := range e.csr.URIs {
 		uris = append(uris, uri.String())
 	}
-	return e.a.evaluateSlice(e.request, uris, e.allowed.URIs, e.fldPath.Child("uris"))
+
+	var otherNames []string
+	for _, on := range e.otherNames {
+		otherNames = append(otherNames, on.String())
+	}
+
+	var usages []string
+	for _, usage := range e.request.Spec.Usages {
+		usages = append(usages, string(usage))
+	}
+
+	var isCA []string
+	if e.request.Spec.IsCA {
+		isCA = []string{strconv.FormatBool(e.request.Spec.IsCA)}
+	}
+
+	sub := e.Subject()
+	return []attribute{
+		{e.fldPath.Child("commonName"), sub.values(utilpki.OIDConstants.CommonName), stringDefined(e.allowed.CommonName), e.CommonName},
+		{e.fldPath.Child("dnsNames"), e.csr.DNSNames, sliceDefined(e.allowed.DNSNames), e.DNSNames},
+		{e.fldPath.Child("ipAddresses"), ips, sliceDefined(e.allowed.IPAddresses), e.IPAddresses},
+		{e.fldPath.Child("uris"), uris, urisDefined(e.allowed.URIs), e.URIs},
+		{e.fldPath.Child("emailAddresses"), e.csr.EmailAddresses, sliceDefined(e.allowed.EmailAddresses), e.EmailAddresses},
+		{e.fldPath.Child("otherNames"), otherNames, len(e.allowed.OtherNames) > 0, e.OtherNames},
+		{e.fldPath.Child("isCA"), isCA, ptr.Deref(e.allowed.IsCA, false), e.IsCA},
+		{e.fldPath.Child("usages"), usages, e.allowed.Usages != nil, e.Usages},
+		{sub.fldPath.Child("organizations"), sub.sub.Organization, sliceDefined(sub.allowed.Organizations), sub.Organization},
+		{sub.fldPath.Child("countries"), sub.sub.Country, sliceDefined(sub.allowed.Countries), sub.Country},
+		{sub.fldPath.Child("organizationalUnits"), sub.sub.OrganizationalUnit, sliceDefined(sub.allowed.OrganizationalUnits), sub.OrganizationalUnit},
+		{sub.fldPath.Child("localities"), sub.sub.Locality, sliceDefined(sub.allowed.Localities), sub.Locality},
+		{sub.fldPath.Child("provinces"), sub.sub.Province, sliceDefined(sub.allowed.Provinces), sub.Province},
+		{sub.fldPath.Child("streetAddresses"), sub.sub.StreetAddress, sliceDefined(sub.allowed.StreetAddresses), sub.StreetAddress},
+		{sub.fldPath.Child("postalCodes"), sub.sub.PostalCode, sliceDefined(sub.allowed.PostalCodes), sub.PostalCode},
+		{sub.fldPath.Child("serialNumber"), sub.values(utilpki.OIDConstants.SerialNumber), stringDefined(sub.allowed.SerialNumber), sub.SerialNumber},
+		{sub.fldPath.Child("domainComponents"), sub.values(utilpki.OIDConstants.DomainComponent), sliceDefined(sub.allowed.DomainComponents), sub.DomainComponent},
+		{sub.fldPath.Child("userIDs"), sub.values(utilpki.OIDConstants.UniqueIdentifier), sliceDefined(sub.allowed.UserIDs), sub.UserID},
+		{sub.fldPath.Child("titles"), sub.values(oidTitle), sliceDefined(sub.allowed.Titles), sub.Title},
+		{sub.fldPath.Child("emailAddresses"), sub.values(oidEmailAddress), sliceDefined(sub.allowed.EmailAddresses), sub.EmailAddress},
+		{sub.fldPath.Child("attributes"), sub.otherAttributes(), len(sub.allowed.Attributes) > 0 || ptr.Deref(sub.allowed.DenyUnknownAttributes, false), sub.Attributes},
+		{e.fldPath.Child("extensions"), e.extensionOIDs(), len(e.allowed.Extensions) > 0 || ptr.Deref(e.allowed.DenyUnknownExtensions, false), e.Extensions},
+	}
+}
+
+func (e evaluator) CommonName(values []string) field.ErrorList {
+	return e.a.evaluateStrings(e.cr, values, e.allowed.CommonName, e.fldPath.Child("commonName"))
+}
+
+func (e evaluator) DNSNames(values []string) field.ErrorList {
+	var (
+		matching = ptr.Deref(e.allowed.DNSNameMatching, policyapi.CertificateRequestPolicyAllowedDNSNameMatching{})
+		dnsMode  = matching.Mode == policyapi.CertificateRequestPolicyMatchModeDNS
+		subset   = util.WildcardSubset
+	)
+	if dnsMode {
+		subset = func(patterns, members []string) bool {
+			// Names which cannot be normalised are never allowed by Allow
+			// policies, but are matched by Deny policies, so that they can't be
+			// used to avoid them.
+			ok, err := util.DNSSubset(patterns, members)
+			if err != nil {
+				return e.deny
+			}
+			return ok
+		}
+	}
+
+	el := e.a.evaluateSliceWith(e.cr, values, e.allowed.DNSNames, e.fldPath.Child("dnsNames"), subset)
+
+	// Deny policies match wildcard names like any other name, so that
+	// requesting a wildcard name can't be used to avoid matching them.
+	if !e.deny && !ptr.Deref(matching.AllowWildcardNames, !dnsMode) {
+		var wildcardNames []string
+		for _, value := range values {
+			if util.IsWildcardDNSName(value) {
+				wildcardNames = append(wildcardNames, value)
+			}
+		}
+		if len(wildcardNames) > 0 {
+			el = append(el, field.Invalid(e.fldPath.Child("dnsNameMatching", "allowWildcardNames"), wildcardNames, "false"))
+		}
+	}
+
+	return el
 }
 
-func (e evaluator) EmailAddresses() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.csr.EmailAddresses, e.allowed.EmailAddresses, e.fldPath.Child("emailAddresses"))
+func (e evaluator) IPAddresses(values []string) field.ErrorList {
+	return e.a.evaluateSliceWith(e.cr, values, e.allowed.IPAddresses, e.fldPath.Child("ipAddresses"), util.IPSubset)
 }
 
-func (e evaluator) IsCA() field.ErrorList {
-	return e.a.evaluateBool(e.request.Spec.IsCA, e.allowed.IsCA, e.fldPath.Child("isCA"))
+func (e evaluator) EmailAddresses(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.EmailAddresses, e.fldPath.Child("emailAddresses"))
 }
 
-func (e evaluator) Usages() field.ErrorList {
+func (e evaluator) IsCA(values []string) field.ErrorList {
+	return e.a.evaluateBool(len(values) > 0, e.allowed.IsCA, e.fldPath.Child("isCA"))
+}
+
+func (e evaluator) Usages(values []string) field.ErrorList {
 	var el field.ErrorList
-	if len(e.request.Spec.Usages) > 0 {
-		var requestUsages []string
-		for _, usage := range e.request.Spec.Usages {
-			requestUsages = append(requestUsages, string(usage))
-		}
+	if len(values) > 0 {
 		if e.allowed.Usages == nil {
-			el = append(el, field.Invalid(e.fldPath.Child("usages"), requestUsages, "nil"))
+			el = append(el, field.Invalid(e.fldPath.Child("usages"), values, "nil"))
 		} else {
 			var policyUsages []string
 			for _, usage := range *e.allowed.Usages {
 				policyUsages = append(policyUsages, string(usage))
 			}
-			if !util.WildcardSubset(policyUsages, requestUsages) {
-				el = append(el, field.Invalid(e.fldPath.Child("usages"), requestUsages, strings.Join(policyUsages, ", ")))
+			if !util.WildcardSubset(policyUsages, values) {
+				el = append(el, field.Invalid(e.fldPath.Child("usages"), values, strings.Join(policyUsages, ", ")))
 			}
 		}
 	}
@@ -169,7 +387,7 @@ func (e evaluator) Subject() subjectEvaluator {
 	}
 	return subjectEvaluator{
 		a:       e.a,
-		request: e.request,
+		cr:      e.cr,
 		sub:     e.csr.Subject,
 		allowed: allowed,
 		fldPath: e.fldPath.Child("subject"),
@@ -178,45 +396,60 @@ func (e evaluator) Subject() subjectEvaluator {
 
 type subjectEvaluator struct {
 	a       allowed
-	request *cmapi.CertificateRequest
+	cr      *validation.CertificateRequest
 	sub     pkix.Name
 	allowed *policyapi.CertificateRequestPolicyAllowedX509Subject
 	fldPath *field.Path
 }
 
-func (e subjectEvaluator) Organization() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.Organization, e.allowed.Organizations, e.fldPath.Child("organizations"))
+func (e subjectEvaluator) Organization(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.Organizations, e.fldPath.Child("organizations"))
 }
 
-func (e subjectEvaluator) Country() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.Country, e.allowed.Countries, e.fldPath.Child("countries"))
+func (e subjectEvaluator) Country(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.Countries, e.fldPath.Child("countries"))
 }
 
-func (e subjectEvaluator) OrganizationalUnit() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.OrganizationalUnit, e.allowed.OrganizationalUnits, e.fldPath.Child("organizationalUnits"))
+func (e subjectEvaluator) OrganizationalUnit(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.OrganizationalUnits, e.fldPath.Child("organizationalUnits"))
 }
 
-func (e subjectEvaluator) Locality() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.Locality, e.allowed.Localities, e.fldPath.Child("localities"))
+func (e subjectEvaluator) Locality(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.Localities, e.fldPath.Child("localities"))
 }
 
-func (e subjectEvaluator) Province() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.Province, e.allowed.Provinces, e.fldPath.Child("provinces"))
+func (e subjectEvaluator) Province(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.Provinces, e.fldPath.Child("provinces"))
 }
 
-func (e subjectEvaluator) StreetAddress() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.StreetAddress, e.allowed.StreetAddresses, e.fldPath.Child("streetAddresses"))
+func (e subjectEvaluator) StreetAddress(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.StreetAddresses, e.fldPath.Child("streetAddresses"))
 }
 
-func (e subjectEvaluator) PostalCode() field.ErrorList {
-	return e.a.evaluateSlice(e.request, e.sub.PostalCode, e.allowed.PostalCodes, e.fldPath.Child("postalCodes"))
+func (e subjectEvaluator) PostalCode(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.PostalCodes, e.fldPath.Child("postalCodes"))
 }
 
-func (e subjectEvaluator) SerialNumber() field.ErrorList {
-	return e.a.evaluateString(e.request, e.sub.SerialNumber, e.allowed.SerialNumber, e.fldPath.Child("serialNumber"))
+func (e subjectEvaluator) SerialNumber(values []string) field.ErrorList {
+	return e.a.evaluateStrings(e.cr, values, e.allowed.SerialNumber, e.fldPath.Child("serialNumber"))
 }
 
-func (a allowed) evaluateString(request *cmapi.CertificateRequest, s string, crp *policyapi.CertificateRequestPolicyAllowedString, fldPath *field.Path) field.ErrorList {
+// evaluateStrings evaluates every requested value of a string attribute. A
+// subject may contain more than one attribute of a type which is allowed as a
+// single string, such as the common name, and each of them must be allowed.
+func (a allowed) evaluateStrings(cr *validation.CertificateRequest, values []string, crp *policyapi.CertificateRequestPolicyAllowedString, fldPath *field.Path) field.ErrorList {
+	if len(values) == 0 {
+		return a.evaluateString(cr, "", crp, fldPath)
+	}
+
+	var el field.ErrorList
+	for _, s := range values {
+		el = append(el, a.evaluateString(cr, s, crp, fldPath)...)
+	}
+	return el
+}
+
+func (a allowed) evaluateString(cr *validation.CertificateRequest, s string, crp *policyapi.CertificateRequestPolicyAllowedString, fldPath *field.Path) field.ErrorList {
 	if len(s) == 0 {
 		// Attribute not set in request. We will only check if it's a required attribute
 		// and not run any validations specified by the policy.
@@ -238,12 +471,18 @@ func (a allowed) evaluateString(request *cmapi.CertificateRequest, s string, crp
 	}
 
 	if len(crp.Validations) > 0 {
-		el = append(el, a.runValidations(request, crp.Validations, s, fldPath.Child("validations"))...)
+		el = append(el, a.runValidations(cr, crp.Validations, s, fldPath.Child("validations"))...)
 	}
 	return el
 }
 
-func (a allowed) evaluateSlice(request *cmapi.CertificateRequest, s []string, crp *policyapi.CertificateRequestPolicyAllowedStringSlice, fldPath *field.Path) field.ErrorList {
+func (a allowed) evaluateSlice(cr *validation.CertificateRequest, s []string, crp *policyapi.CertificateRequestPolicyAllowedStringSlice, fldPath *field.Path) field.ErrorList {
+	return a.evaluateSliceWith(cr, s, crp, fldPath, util.WildcardSubset)
+}
+
+// evaluateSliceWith evaluates a slice attribute, where subset returns whether
+// the requested values are allowed by the values of the policy.
+func (a allowed) evaluateSliceWith(cr *validation.CertificateRequest, s []string, crp *policyapi.CertificateRequestPolicyAllowedStringSlice, fldPath *field.Path, subset func(patterns, members []string) bool) field.ErrorList {
 	if len(s) == 0 {
 		// Attribute not set in request. We will only check if it's a required attribute
 		// and not run any validations specified by the policy.
@@ -260,14 +499,14 @@ func (a allowed) evaluateSlice(request *cmapi.CertificateRequest, s []string, cr
 	}
 
 	var el field.ErrorList
-	if crp.Values != nil && !util.WildcardSubset(*crp.Values, s) {
+	if crp.Values != nil && !subset(*crp.Values, s) {
 		el = append(el, field.Invalid(fldPath.Child("values"), s, strings.Join(*crp.Values, ", ")))
 	}
 
 	if len(crp.Validations) > 0 {
 		fldPath := fldPath.Child("validations")
 		for _, v := range s {
-			el = append(el, a.runValidations(request, crp.Validations, v, fldPath)...)
+			el = append(el, a.runValidations(cr, crp.Validations, v, fldPath)...)
 		}
 	}
 	return el
@@ -285,7 +524,7 @@ func (a allowed) evaluateBool(b bool, crp *bool, fldPath *field.Path) field.Erro
 	return el
 }
 
-func (a allowed) runValidations(request *cmapi.CertificateRequest, validations []policyapi.ValidationRule, s string, fldPath *field.Path) field.ErrorList {
+func (a allowed) runValidations(cr *validation.CertificateRequest, validations []policyapi.ValidationRule, s string, fldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 	for i, v := range validations {
 		validator, err := a.validators.Get(v.Rule)
@@ -293,7 +532,7 @@ func (a allowed) runValidations(request *cmapi.CertificateRequest, validations [
 			el = append(el, field.InternalError(fldPath.Index(i), err))
 			continue
 		}
-		valid, err := validator.Validate(s, *request)
+		valid, err := validator.Validate(s, cr)
 		if err != nil {
 			el = append(el, field.InternalError(fldPath.Index(i), err))
 			continue
@@ -305,3 +544,15 @@ func (a allowed) runValidations(request *cmapi.CertificateRequest, validations [
 	}
 	return el
 }
+
+// stringDefined returns true if the allowed string defines a value or
+// validations.
+func stringDefined(crp *policyapi.CertificateRequestPolicyAllowedString) bool {
+	return crp != nil && (crp.Value != nil || len(crp.Validations) > 0)
+}
+
+// sliceDefined returns true if the allowed string slice defines values or
+// validations.
+func sliceDefined(crp *policyapi.CertificateRequestPolicyAllowedStringSlice) bool {
+	return crp != nil && (crp.Values != nil || len(crp.Validations) > 0)
+}
@@ -19,11 +19,13 @@ package allowed
 import (
 	"context"
 	"crypto/x509"
+	"encoding/asn1"
 	"net"
 	"net/url"
 	"testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -44,6 +46,25 @@ func Test_Evaluate(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// malformedOtherName requests an otherName SAN whose value has trailing
+	// data.
+	malformedOtherName := func(csr *x509.CertificateRequest) error {
+		oid, err := utilpki.ParseObjectIdentifier(oidUPN)
+		if err != nil {
+			return err
+		}
+		ext, err := utilpki.MarshalSANs(utilpki.GeneralNames{OtherNames: []utilpki.OtherName{{
+			TypeID: oid,
+			Value:  asn1.RawValue{Tag: 0, Class: asn1.ClassContextSpecific, IsCompound: true, Bytes: []byte{0x0c, 0x01, 'a', 0x00}},
+		}}}, true)
+		if err != nil {
+			return err
+		}
+		csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+		return nil
+	}
+	malformedOtherNameErr := field.Invalid(requestPath, "SubjectAltName", "failed to decode otherName "+oidUPN+": trailing data")
+
 	tests := map[string]struct {
 		policy      policyapi.CertificateRequestPolicySpec
 		request     *cmapi.CertificateRequest
@@ -124,7 +145,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("hello-world2")},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"example.com2", "foo.bar2"}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"1.1.1.12", "2.3.4.52"}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/ns/foo/sa/bar2", "foo.bar.com2"}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/ns/foo/sa/bar2", "foo.bar.com2"}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"foo@example.com2", "bar@example.com2"}},
 					IsCA:           ptr.To(false),
 					Usages:         &[]cmapi.KeyUsage{cmapi.UsageCRLSign, cmapi.UsageServerAuth},
@@ -185,7 +206,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("hello-world")},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"example.com", "foo.bar", "*.example.com"}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"1.1.1.1", "2.3.4.5"}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/ns/foo/sa/bar", "foo.bar.com"}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/ns/foo/sa/bar", "foo.bar.com"}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"foo@example.com", "bar@example.com"}},
 					IsCA:           ptr.To(true),
 					Usages:         &[]cmapi.KeyUsage{cmapi.UsageCRLSign, cmapi.UsageClientAuth},
@@ -230,7 +251,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("hello-*")},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"example.*", "*.bar"}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"1.1*", "*2.3.4.5"}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/*/foo/sa/bar", "*.bar.com"}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://cluster.local/*/foo/sa/bar", "*.bar.com"}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"foo@*", "*r@example.com"}},
 					IsCA:           ptr.To(true),
 					Usages:         &[]cmapi.KeyUsage{cmapi.UsageCRLSign, cmapi.UsageClientAuth},
@@ -258,7 +279,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Required: ptr.To(true), Value: ptr.To("*")},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
 					Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 						Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
@@ -312,7 +333,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Required: ptr.To(true), Value: ptr.To("*")},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
 					Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 						Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}},
@@ -352,7 +373,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Validations: []policyapi.ValidationRule{{Rule: "self.contains('cn-1')"}}},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.endsWith(cr.namespace + '.svc')", Message: ptr.To("only local namespace DNS names are allowed")}}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('10.0.1.')"}}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://cluster.local/ns/' + cr.namespace + '/sa/')", Message: ptr.To("must be a namespced SPIFFE ID in local trust domain")}}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://cluster.local/ns/' + cr.namespace + '/sa/')", Message: ptr.To("must be a namespced SPIFFE ID in local trust domain")}}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self == cr.namespace + '@example.com'"}}},
 					Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 						Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self == 'company-1'"}}},
@@ -408,7 +429,7 @@ func Test_Evaluate(t *testing.T) {
 					CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Validations: []policyapi.ValidationRule{{Rule: "self.contains('cn-1')"}}},
 					DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.endsWith(cr.namespace + '.svc')", Message: ptr.To("only local namespace DNS names are allowed")}}},
 					IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('10.0.1.')"}}},
-					URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://cluster.local/ns/' + cr.namespace + '/sa/')", Message: ptr.To("must be a namespced SPIFFE ID in local trust domain")}}},
+					URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://cluster.local/ns/' + cr.namespace + '/sa/')", Message: ptr.To("must be a namespced SPIFFE ID in local trust domain")}}}},
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self == cr.namespace + '@example.com'"}}},
 					Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 						Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self == 'company-1'"}}},
@@ -441,7 +462,7 @@ func Test_Evaluate(t *testing.T) {
 					// Allowed by values and validations
 					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.com"}, Validations: []policyapi.ValidationRule{{Rule: "self.endsWith(cr.namespace + '.com')"}}},
 					// Denied by validation
-					URIs: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://*"}, Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://foo.bar/ns/')"}}},
+					URIs: &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"spiffe://*"}, Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://foo.bar/ns/')"}}}},
 					// Denied by values and validations
 					EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"foo@example.com"}, Validations: []policyapi.ValidationRule{{Rule: "self == cr.namespace + '@example.com'"}}},
 				},
@@ -456,6 +477,417 @@ func Test_Evaluate(t *testing.T) {
 				}.ToAggregate().Error(),
 			},
 		},
+		"if Deny policy with no allowed defined, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("hello-world"),
+				gen.SetCSRDNSNames("example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect:  policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: nil,
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: ""},
+		},
+		"if Deny policy and any requested value matches defined attribute, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("hello-world"),
+				gen.SetCSRDNSNames("example.com", "foo.corp.internal", "bar.corp.internal"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: foo.corp.internal, bar.corp.internal"},
+		},
+		"if Deny policy in DNS mode and a requested wildcard name matches defined attribute, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("example.com", "*.corp.internal"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: *.corp.internal"},
+		},
+		"if Deny policy in DNS mode and a requested name cannot be normalised, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("example.com", "xn--a.corp.internal"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: xn--a.corp.internal"},
+		},
+		"if Deny policy which disallows wildcard names and a requested wildcard name matches defined attribute, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("*.corp.internal"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{AllowWildcardNames: ptr.To(false)},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: *.corp.internal"},
+		},
+		"if Deny policy and no requested value matches defined attribute, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.NotFound(field.NewPath("spec.allowed.dnsNames"), []string{"example.com"}),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if Deny policy and all defined attributes are matched, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("foo.corp.internal"),
+			)),
+				gen.SetCertificateRequestIsCA(true),
+			),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.endsWith('.corp.internal')"}}},
+					IsCA:     ptr.To(true),
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: foo.corp.internal, spec.allowed.isCA: true"},
+		},
+		"if Deny policy and one defined attribute is not matched, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("foo.corp.internal"),
+			)),
+				gen.SetCertificateRequestIsCA(false),
+			),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.corp.internal"}},
+					IsCA:     ptr.To(true),
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.NotFound(field.NewPath("spec.allowed.isCA"), []string(nil)),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if validations pass, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("foo.example.com"),
+				gen.SetCSRDNSNames("foo.example.com", "bar.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					CommonName: &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("*")},
+					DNSNames:   &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}},
+				},
+				Validations: []policyapi.ValidationRule{
+					{Rule: "cr.csr.subject.commonName in cr.csr.dnsNames"},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: ""},
+		},
+		"if validations fail, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("foo.example.com"),
+				gen.SetCSRDNSNames("bar.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					CommonName: &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("*")},
+					DNSNames:   &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}},
+				},
+				Validations: []policyapi.ValidationRule{
+					{Rule: "cr.csr.dnsNames.size() < 3"},
+					{Rule: "cr.csr.subject.commonName in cr.csr.dnsNames"},
+					{Rule: "cr.csr.dnsNames.all(d, d.startsWith('foo.'))", Message: ptr.To("DNS names must start with foo.")},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(field.NewPath("spec.validations[1]"), "failed rule: cr.csr.subject.commonName in cr.csr.dnsNames"),
+					field.Forbidden(field.NewPath("spec.validations[2]"), "DNS names must start with foo."),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if Deny policy and all validations pass, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("foo.example.com"),
+				gen.SetCSRDNSNames("bar.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Validations: []policyapi.ValidationRule{
+					{Rule: "!(cr.csr.subject.commonName in cr.csr.dnsNames)"},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.validations[0]: !(cr.csr.subject.commonName in cr.csr.dnsNames)"},
+		},
+		"if Deny policy and one validation fails, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("foo.example.com"),
+				gen.SetCSRDNSNames("foo.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Validations: []policyapi.ValidationRule{
+					{Rule: "cr.csr.dnsNames.size() > 0"},
+					{Rule: "!(cr.csr.subject.commonName in cr.csr.dnsNames)"},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(field.NewPath("spec.validations[1]"), "failed rule: !(cr.csr.subject.commonName in cr.csr.dnsNames)"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if Deny policy and a validation fails to evaluate, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRCommonName("foo.example.com"),
+				gen.SetCSRDNSNames("foo.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Validations: []policyapi.ValidationRule{
+					{Rule: "cr.annotations['team'] == 'prod'"},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.validations[0]: cr.annotations['team'] == 'prod' (failed to evaluate)"},
+		},
+		"if Deny policy and an attribute validation fails to evaluate, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("foo.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{
+						Validations: []policyapi.ValidationRule{{Rule: "self == cr.annotations['team'] + '.example.com'"}},
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: foo.example.com"},
+		},
+		"if Deny policy and an attribute fails to evaluate but another is not matched, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("foo.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{
+						Values:      &[]string{"*.example.org"},
+						Validations: []policyapi.ValidationRule{{Rule: "self == cr.annotations['team'] + '.example.com'"}},
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.NotFound(field.NewPath("spec.allowed.dnsNames"), []string{"foo.example.com"}),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests a CA certificate but the request is not a CA, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				noErrModifier(func(csr *x509.CertificateRequest) {
+					ext, _ := utilpki.MarshalBasicConstraints(true, nil)
+					csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+				}),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{IsCA: ptr.To(true)},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(field.NewPath("spec.request"), "CSR BasicConstraints CA:true contradicts spec.isCA: false"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests DNS names matching in DNS mode, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("a.example.com", "B.C.Example.com."),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com", "**.c.example.com"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if CSR requests DNS names not matching in DNS mode, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("a.b.example.com", "*.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.dnsNames.values"), []string{"a.b.example.com", "*.example.com"}, "*.example.com"),
+					field.Invalid(field.NewPath("spec.allowed.dnsNameMatching.allowWildcardNames"), []string{"*.example.com"}, "false"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests DNS names which cannot be normalised in DNS mode, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("xn--a.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.dnsNames.values"), []string{"xn--a.example.com"}, "*.example.com"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests wildcard DNS names allowed in DNS mode, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("*.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{
+						Mode: policyapi.CertificateRequestPolicyMatchModeDNS, AllowWildcardNames: ptr.To(true),
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if CSR requests wildcard DNS names forbidden in Glob mode, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRDNSNames("*.example.com"),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}},
+					DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{AllowWildcardNames: ptr.To(false)},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.dnsNameMatching.allowWildcardNames"), []string{"*.example.com"}, "false"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests IP addresses within allowed CIDRs, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRIPAddresses(net.ParseIP("10.20.1.2"), net.ParseIP("::ffff:10.20.3.4"), net.ParseIP("fd00::1")),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					IPAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"10.20.0.0/16", "fd00::/8"}},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if CSR requests IP addresses outside allowed CIDRs, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				gen.SetCSRIPAddresses(net.ParseIP("10.20.1.2"), net.ParseIP("10.21.1.2")),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					IPAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"10.20.0.0/16", "fd00::/8"}},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.ipAddresses.values"), []string{"10.20.1.2", "10.21.1.2"}, "10.20.0.0/16, fd00::/8"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests an otherName SAN which is allowed, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				withOtherNames(t, utilpki.UniversalValue{UTF8String: "user@example.com"})(oidUPN),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{OtherNames: []policyapi.CertificateRequestPolicyAllowedOtherName{
+					{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*@example.com"}}},
+				}},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if CSR requests an otherName SAN but none are allowed, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t,
+				withOtherNames(t, utilpki.UniversalValue{UTF8String: "user@example.com"})(oidUPN),
+			))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.otherNames"), []string{oidUPN}, "no allowed otherName types"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR requests a malformed otherName SAN, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, malformedOtherName))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{OtherNames: []policyapi.CertificateRequestPolicyAllowedOtherName{
+					{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}}},
+				}},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result:  approver.ResultDenied,
+				Message: field.ErrorList{malformedOtherNameErr}.ToAggregate().Error(),
+			},
+		},
+		"if Deny policy and CSR requests a malformed otherName SAN, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, malformedOtherName))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Effect: policyapi.CertificateRequestPolicyEffectDeny,
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{OtherNames: []policyapi.CertificateRequestPolicyAllowedOtherName{
+					{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*@example.com"}}},
+				}},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: malformedOtherNameErr.Error() + " (failed to evaluate)"},
+		},
+		"if CSR can't be decoded, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR([]byte("foo"))),
+			policy:  policyapi.CertificateRequestPolicySpec{},
+			expResponse: approver.EvaluationResponse{
+				Result:  approver.ResultDenied,
+				Message: field.ErrorList{field.Invalid(requestPath, "CSR", "error decoding certificate request PEM block")}.ToAggregate().Error(),
+			},
+		},
 	}
 
 	for name, test := range tests {
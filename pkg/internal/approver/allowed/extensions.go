@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+)
+
+var (
+	// oidExtensionSubjectAltName is the OID of the SubjectAltName extension.
+	oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+	// evaluatedExtensions are the OIDs of the extensions which are evaluated
+	// by other allowed attributes, and so are only evaluated as extensions if
+	// they are listed in the policy.
+	evaluatedExtensions = []asn1.ObjectIdentifier{
+		oidExtensionSubjectAltName,
+		utilpki.OIDExtensionKeyUsage,
+		utilpki.OIDExtensionExtendedKeyUsage,
+		utilpki.OIDExtensionBasicConstraints,
+	}
+
+	// extensionValueRegexp matches allowed extension values, which are
+	// lowercase hexadecimal with wildcards.
+	extensionValueRegexp = regexp.MustCompile(`^[0-9a-f*]*$`)
+)
+
+// extensionOIDs returns the OIDs of the extensions in the CSR which are
+// evaluated against the allowed extensions of the policy.
+func (e evaluator) extensionOIDs() []string {
+	var oids []string
+	for _, ext := range e.csr.Extensions {
+		oid := ext.Id.String()
+		if slices.ContainsFunc(evaluatedExtensions, ext.Id.Equal) && e.allowedExtension(oid) < 0 {
+			continue
+		}
+		if !slices.Contains(oids, oid) {
+			oids = append(oids, oid)
+		}
+	}
+	return oids
+}
+
+// allowedExtension returns the index of the allowed extension with the given
+// OID, or -1 if the policy does not list it.
+func (e evaluator) allowedExtension(oid string) int {
+	return slices.IndexFunc(e.allowed.Extensions, func(ext policyapi.CertificateRequestPolicyAllowedExtension) bool {
+		return ext.OID == oid
+	})
+}
+
+// Extensions evaluates the CSR extensions with the given OIDs against the
+// allowed extensions of the policy.
+func (e evaluator) Extensions(values []string) field.ErrorList {
+	var (
+		el      field.ErrorList
+		fldPath = e.fldPath.Child("extensions")
+	)
+
+	for i, allowedExt := range e.allowed.Extensions {
+		if ptr.Deref(allowedExt.Required, false) && !slices.Contains(values, allowedExt.OID) {
+			el = append(el, field.Required(fldPath.Index(i).Child("required"), strconv.FormatBool(*allowedExt.Required)))
+		}
+	}
+
+	for _, oid := range values {
+		i := e.allowedExtension(oid)
+		if i < 0 {
+			if ptr.Deref(e.allowed.DenyUnknownExtensions, false) {
+				el = append(el, field.Invalid(fldPath, oid, "extension not allowed"))
+			}
+			continue
+		}
+
+		allowedExt := e.allowed.Extensions[i]
+		extPath := fldPath.Index(i)
+
+		for _, ext := range e.csr.Extensions {
+			if ext.Id.String() != oid {
+				continue
+			}
+
+			value := hex.EncodeToString(ext.Value)
+
+			el = append(el, e.a.evaluateBool(ext.Critical, allowedExt.Critical, extPath.Child("critical"))...)
+
+			if allowedExt.Values != nil && !slices.ContainsFunc(allowedExt.Values, func(allowedValue string) bool {
+				return util.WildcardMatches(allowedValue, value)
+			}) {
+				el = append(el, field.Invalid(extPath.Child("values"), value, strings.Join(allowedExt.Values, ", ")))
+			}
+
+			if len(allowedExt.Validations) > 0 {
+				el = append(el, e.a.runValidations(e.cr, allowedExt.Validations, value, extPath.Child("validations"))...)
+			}
+		}
+	}
+
+	return el
+}
+
+// validateExtensions validates the allowed extensions of a policy.
+func (a allowed) validateExtensions(extensions []policyapi.CertificateRequestPolicyAllowedExtension, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	for i, ext := range extensions {
+		extPath := fldPath.Index(i)
+
+		for j, value := range ext.Values {
+			if !extensionValueRegexp.MatchString(value) {
+				el = append(el, field.Invalid(extPath.Child("values").Index(j), value, "must be lowercase hexadecimal, optionally containing wildcards"))
+			}
+		}
+
+		for j, validation := range ext.Validations {
+			if _, err := a.validators.Get(validation.Rule); err != nil {
+				el = append(el, field.Invalid(extPath.Child("validations").Index(j), validation.Rule, err.Error()))
+			}
+		}
+	}
+
+	return el
+}
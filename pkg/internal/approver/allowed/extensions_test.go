@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
+)
+
+func Test_Extensions(t *testing.T) {
+	// fooExt is a custom extension with the value UTF8String "foo", which is
+	// hex encoded as "0c03666f6f".
+	fooExt := pkix.Extension{Id: asn1.ObjectIdentifier{1, 2, 3, 4}, Value: []byte{0x0c, 0x03, 'f', 'o', 'o'}}
+	withExtension := func(ext pkix.Extension) gen.CSRModifier {
+		return noErrModifier(func(csr *x509.CertificateRequest) {
+			csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+		})
+	}
+	withCritical := func(ext pkix.Extension) pkix.Extension {
+		ext.Critical = true
+		return ext
+	}
+	withKeyUsage := func(csr *x509.CertificateRequest) error {
+		ext, err := utilpki.MarshalKeyUsage(x509.KeyUsageDigitalSignature)
+		csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+		return err
+	}
+
+	fldPath := field.NewPath("spec", "allowed", "extensions")
+
+	tests := map[string]struct {
+		csrMods []gen.CSRModifier
+		allowed policyapi.CertificateRequestPolicyAllowed
+		expOIDs []string
+		expErrs field.ErrorList
+	}{
+		"if CSR has no extensions and none are allowed, return no errors": {
+			expOIDs: nil,
+			expErrs: nil,
+		},
+		"if CSR has an unlisted extension and unknown extensions are not denied, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(fooExt)},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: nil,
+		},
+		"if CSR has an unlisted extension and unknown extensions are denied, return error": {
+			csrMods: []gen.CSRModifier{withExtension(fooExt)},
+			allowed: policyapi.CertificateRequestPolicyAllowed{DenyUnknownExtensions: ptr.To(true)},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: field.ErrorList{field.Invalid(fldPath, "1.2.3.4", "extension not allowed")},
+		},
+		"if CSR has an extension evaluated by another attribute and unknown extensions are denied, return no errors": {
+			csrMods: []gen.CSRModifier{withKeyUsage},
+			allowed: policyapi.CertificateRequestPolicyAllowed{DenyUnknownExtensions: ptr.To(true)},
+			expOIDs: nil,
+			expErrs: nil,
+		},
+		"if CSR has a listed extension evaluated by another attribute, evaluate it": {
+			csrMods: []gen.CSRModifier{withKeyUsage},
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "2.5.29.15", Values: []string{"03020780"}, Critical: ptr.To(true)},
+			}},
+			expOIDs: []string{"2.5.29.15"},
+			expErrs: nil,
+		},
+		"if CSR has a listed extension matching a wildcard value, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(fooExt)},
+			allowed: policyapi.CertificateRequestPolicyAllowed{DenyUnknownExtensions: ptr.To(true), Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4", Values: []string{"0c03*"}},
+			}},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: nil,
+		},
+		"if CSR has a listed extension not matching the values, return error": {
+			csrMods: []gen.CSRModifier{withExtension(fooExt)},
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4", Values: []string{"0c03626172"}},
+			}},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Index(0).Child("values"), "0c03666f6f", "0c03626172")},
+		},
+		"if CSR has a listed extension failing a validation, return error": {
+			csrMods: []gen.CSRModifier{withExtension(fooExt)},
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4", Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('0c')"}, {Rule: "self.size() < 4", Message: ptr.To("too long")}}},
+			}},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Index(0).Child("validations").Index(1), "0c03666f6f", "too long")},
+		},
+		"if CSR has a critical extension which is not allowed to be critical, return error": {
+			csrMods: []gen.CSRModifier{withExtension(withCritical(fooExt))},
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4"},
+			}},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Index(0).Child("critical"), true, "nil")},
+		},
+		"if CSR has a critical extension which is allowed to be critical, return no errors": {
+			csrMods: []gen.CSRModifier{withExtension(withCritical(fooExt))},
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4", Critical: ptr.To(true)},
+			}},
+			expOIDs: []string{"1.2.3.4"},
+			expErrs: nil,
+		},
+		"if CSR is missing a required extension, return error": {
+			allowed: policyapi.CertificateRequestPolicyAllowed{Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+				{OID: "1.2.3.4", Required: ptr.To(true)},
+			}},
+			expOIDs: nil,
+			expErrs: field.ErrorList{field.Required(fldPath.Index(0).Child("required"), "true")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			csr, err := utilpki.DecodeX509CertificateRequestBytes(csrFrom(t, test.csrMods...))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := evaluator{
+				a:       allowed{validators: validation.NewCache()},
+				request: gen.CertificateRequest(""),
+				csr:     csr,
+				allowed: &test.allowed,
+				fldPath: field.NewPath("spec", "allowed"),
+			}
+
+			oids := e.extensionOIDs()
+			assert.Equal(t, test.expOIDs, oids)
+			assert.Equal(t, test.expErrs, e.Extensions(oids))
+		})
+	}
+}
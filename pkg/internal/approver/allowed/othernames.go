@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+// oidValueSeparator separates the type OID from the value in the attribute
+// values of otherName SANs and subject attributes. OIDs never contain it.
+const oidValueSeparator = "="
+
+// otherName is an otherName SAN requested in a CSR.
+type otherName struct {
+	oid   string
+	value string
+}
+
+func (o otherName) String() string {
+	return o.oid + oidValueSeparator + o.value
+}
+
+// requestOtherNames decodes the otherName SANs of the CSR, which are not
+// parsed by crypto/x509. Values of string types are decoded as strings, and
+// values of any other type as the lowercase hexadecimal encoding of their DER
+// value.
+func requestOtherNames(csr *x509.CertificateRequest) ([]otherName, error) {
+	var otherNames []otherName
+	for _, ext := range csr.Extensions {
+		if !ext.Id.Equal(oidExtensionSubjectAltName) {
+			continue
+		}
+
+		gns, err := utilpki.UnmarshalSANs(ext.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode subject alternative names: %w", err)
+		}
+
+		for _, on := range gns.OtherNames {
+			var raw asn1.RawValue
+			rest, err := asn1.Unmarshal(on.Value.Bytes, &raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode otherName %s: %w", on.TypeID, err)
+			}
+			if len(rest) > 0 {
+				return nil, fmt.Errorf("failed to decode otherName %s: trailing data", on.TypeID)
+			}
+
+			uv, err := utilpki.UnmarshalUniversalValue(raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode otherName %s: %w", on.TypeID, err)
+			}
+
+			var value string
+			switch uv.Type() {
+			case utilpki.UniversalValueTypeUTF8String:
+				value = uv.UTF8String
+			case utilpki.UniversalValueTypeIA5String:
+				value = uv.IA5String
+			case utilpki.UniversalValueTypePrintableString:
+				value = uv.PrintableString
+			default:
+				value = hex.EncodeToString(uv.Bytes)
+			}
+
+			otherNames = append(otherNames, otherName{oid: on.TypeID.String(), value: value})
+		}
+	}
+	return otherNames, nil
+}
+
+// OtherNames evaluates the given otherName SANs, formatted as
+// "<oid>=<value>", against the allowed otherNames of the policy. Each type of
+// otherName SAN is evaluated as its own slice attribute.
+func (e evaluator) OtherNames(values []string) field.ErrorList {
+	var (
+		el      field.ErrorList
+		fldPath = e.fldPath.Child("otherNames")
+		byOID   = make(map[string][]string)
+	)
+
+	for _, v := range values {
+		oid, value, _ := strings.Cut(v, oidValueSeparator)
+		byOID[oid] = append(byOID[oid], value)
+	}
+
+	for i, allowedOtherName := range e.allowed.OtherNames {
+		el = append(el, e.a.evaluateSlice(e.cr, byOID[allowedOtherName.OID], &allowedOtherName.CertificateRequestPolicyAllowedStringSlice, fldPath.Index(i))...)
+	}
+
+	var unknown []string
+	for oid := range byOID {
+		if !slices.ContainsFunc(e.allowed.OtherNames, func(allowedOtherName policyapi.CertificateRequestPolicyAllowedOtherName) bool {
+			return allowedOtherName.OID == oid
+		}) {
+			unknown = append(unknown, oid)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		el = append(el, field.Invalid(fldPath, unknown, "no allowed otherName types"))
+	}
+
+	return el
+}
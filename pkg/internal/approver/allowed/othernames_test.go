@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"testing"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
+)
+
+const oidUPN = "1.3.6.1.4.1.311.20.2.3"
+
+// withOtherNames returns a CSR modifier which requests the given otherName
+// SANs, alongside the DNS names of the CSR.
+func withOtherNames(t *testing.T, otherNames ...utilpki.UniversalValue) func(oids ...string) gen.CSRModifier {
+	return func(oids ...string) gen.CSRModifier {
+		return func(csr *x509.CertificateRequest) error {
+			sans := utilpki.GeneralNames{DNSNames: csr.DNSNames}
+			for i, uv := range otherNames {
+				oid, err := utilpki.ParseObjectIdentifier(oids[i])
+				require.NoError(t, err)
+				value, err := utilpki.MarshalUniversalValue(uv)
+				require.NoError(t, err)
+				sans.OtherNames = append(sans.OtherNames, utilpki.OtherName{
+					TypeID: oid,
+					Value:  asn1.RawValue{Tag: 0, Class: asn1.ClassContextSpecific, IsCompound: true, Bytes: value},
+				})
+			}
+			ext, err := utilpki.MarshalSANs(sans, true)
+			if err != nil {
+				return err
+			}
+			csr.ExtraExtensions = append(csr.ExtraExtensions, ext)
+			return nil
+		}
+	}
+}
+
+func Test_requestOtherNames(t *testing.T) {
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(csrFrom(t,
+		gen.SetCSRDNSNames("example.com"),
+		withOtherNames(t,
+			utilpki.UniversalValue{UTF8String: "user@example.com"},
+			utilpki.UniversalValue{IA5String: "foo"},
+			utilpki.UniversalValue{Bytes: []byte{0x02, 0x01, 0x05}},
+		)(oidUPN, "1.2.3.4", "1.2.3.5"),
+	))
+	require.NoError(t, err)
+
+	otherNames, err := requestOtherNames(csr)
+	require.NoError(t, err)
+	assert.Equal(t, []otherName{
+		{oid: oidUPN, value: "user@example.com"},
+		{oid: "1.2.3.4", value: "foo"},
+		{oid: "1.2.3.5", value: "020105"},
+	}, otherNames)
+	assert.Equal(t, []string{"example.com"}, csr.DNSNames)
+}
+
+func Test_OtherNames(t *testing.T) {
+	fldPath := field.NewPath("spec", "allowed", "otherNames")
+
+	tests := map[string]struct {
+		values  []string
+		allowed []policyapi.CertificateRequestPolicyAllowedOtherName
+		expErrs field.ErrorList
+	}{
+		"if no otherNames requested and none allowed, return no errors": {
+			expErrs: nil,
+		},
+		"if otherName requested but none allowed, return error": {
+			values:  []string{oidUPN + "=user@example.com"},
+			expErrs: field.ErrorList{field.Invalid(fldPath, []string{oidUPN}, "no allowed otherName types")},
+		},
+		"if otherName requested with a type which is not allowed, return error": {
+			values: []string{"1.2.3.4=foo"},
+			allowed: []policyapi.CertificateRequestPolicyAllowedOtherName{
+				{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}}},
+			},
+			expErrs: field.ErrorList{field.Invalid(fldPath, []string{"1.2.3.4"}, "no allowed otherName types")},
+		},
+		"if otherName requested matching wildcard values, return no errors": {
+			values: []string{oidUPN + "=user@example.com", oidUPN + "=admin@example.com"},
+			allowed: []policyapi.CertificateRequestPolicyAllowedOtherName{
+				{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*@example.com"}}},
+			},
+			expErrs: nil,
+		},
+		"if otherName requested not matching values, return error": {
+			values: []string{oidUPN + "=user@example.com", oidUPN + "=user@example.net"},
+			allowed: []policyapi.CertificateRequestPolicyAllowedOtherName{
+				{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*@example.com"}}},
+			},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Index(0).Child("values"), []string{"user@example.com", "user@example.net"}, "*@example.com")},
+		},
+		"if otherName requested failing validations, return error": {
+			values: []string{oidUPN + "=user@example.com"},
+			allowed: []policyapi.CertificateRequestPolicyAllowedOtherName{
+				{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{
+					Validations: []policyapi.ValidationRule{{Rule: "self.startsWith(cr.namespace + '@')", Message: ptr.To("must start with the namespace")}},
+				}},
+			},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Index(0).Child("validations").Index(0), "user@example.com", "must start with the namespace")},
+		},
+		"if required otherName not requested, return error": {
+			allowed: []policyapi.CertificateRequestPolicyAllowedOtherName{
+				{OID: oidUPN, CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{"*"}}},
+			},
+			expErrs: field.ErrorList{field.Required(fldPath.Index(0).Child("required"), "true")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := evaluator{
+				a:       allowed{validators: validation.NewCache()},
+				request: gen.CertificateRequest("", gen.SetCertificateRequestNamespace("admin")),
+				allowed: &policyapi.CertificateRequestPolicyAllowed{OtherNames: test.allowed},
+				fldPath: field.NewPath("spec", "allowed"),
+			}
+			assert.Equal(t, test.expErrs, e.OtherNames(test.values))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+var (
+	oidTitle        = asn1.ObjectIdentifier{2, 5, 4, 12}
+	oidPostalCode   = asn1.ObjectIdentifier{2, 5, 4, 17}
+	oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
+	// namedSubjectAttributes are the OIDs of the subject attributes which are
+	// evaluated by a dedicated field, rather than by the generic attributes.
+	namedSubjectAttributes = []asn1.ObjectIdentifier{
+		asn1.ObjectIdentifier(utilpki.OIDConstants.CommonName),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.Country),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.Organization),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.OrganizationalUnit),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.Locality),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.Province),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.StreetAddress),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.SerialNumber),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.DomainComponent),
+		asn1.ObjectIdentifier(utilpki.OIDConstants.UniqueIdentifier),
+		oidTitle,
+		oidPostalCode,
+		oidEmailAddress,
+	}
+)
+
+// attributeValue returns the string value of a subject attribute. Values of
+// string types are returned as is, and values of any other type as the
+// lowercase hexadecimal encoding of their DER value.
+func attributeValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	der, err := asn1.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return hex.EncodeToString(der)
+}
+
+// isNamedSubjectAttribute returns whether the subject attribute with the
+// given OID is evaluated by a dedicated field.
+func isNamedSubjectAttribute(oid asn1.ObjectIdentifier) bool {
+	return slices.ContainsFunc(namedSubjectAttributes, oid.Equal)
+}
+
+// values returns the values of the subject attribute with the given OID.
+func (e subjectEvaluator) values(oid asn1.ObjectIdentifier) []string {
+	var values []string
+	for _, atv := range e.sub.Names {
+		if atv.Type.Equal(oid) {
+			values = append(values, attributeValue(atv.Value))
+		}
+	}
+	return values
+}
+
+// otherAttributes returns the subject attributes which are not evaluated by a
+// dedicated field, formatted as "<oid>=<value>".
+func (e subjectEvaluator) otherAttributes() []string {
+	var values []string
+	for _, atv := range e.sub.Names {
+		if !isNamedSubjectAttribute(atv.Type) {
+			values = append(values, atv.Type.String()+oidValueSeparator+attributeValue(atv.Value))
+		}
+	}
+	return values
+}
+
+func (e subjectEvaluator) DomainComponent(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.DomainComponents, e.fldPath.Child("domainComponents"))
+}
+
+func (e subjectEvaluator) UserID(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.UserIDs, e.fldPath.Child("userIDs"))
+}
+
+func (e subjectEvaluator) Title(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.Titles, e.fldPath.Child("titles"))
+}
+
+func (e subjectEvaluator) EmailAddress(values []string) field.ErrorList {
+	return e.a.evaluateSlice(e.cr, values, e.allowed.EmailAddresses, e.fldPath.Child("emailAddresses"))
+}
+
+// Attributes evaluates the given subject attributes, formatted as
+// "<oid>=<value>", against the allowed attributes of the policy. Each type of
+// attribute is evaluated as its own slice attribute.
+func (e subjectEvaluator) Attributes(values []string) field.ErrorList {
+	var (
+		el      field.ErrorList
+		fldPath = e.fldPath.Child("attributes")
+		byOID   = make(map[string][]string)
+	)
+
+	for _, v := range values {
+		oid, value, _ := strings.Cut(v, oidValueSeparator)
+		byOID[oid] = append(byOID[oid], value)
+	}
+
+	for i, allowedAttr := range e.allowed.Attributes {
+		el = append(el, e.a.evaluateSlice(e.cr, byOID[allowedAttr.OID], &allowedAttr.CertificateRequestPolicyAllowedStringSlice, fldPath.Index(i))...)
+	}
+
+	if !ptr.Deref(e.allowed.DenyUnknownAttributes, false) {
+		return el
+	}
+
+	var unknown []string
+	for oid := range byOID {
+		if !slices.ContainsFunc(e.allowed.Attributes, func(allowedAttr policyapi.CertificateRequestPolicyAllowedSubjectAttribute) bool {
+			return allowedAttr.OID == oid
+		}) {
+			unknown = append(unknown, oid)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		el = append(el, field.Invalid(fldPath, unknown, "no allowed subject attribute types"))
+	}
+
+	return el
+}
+
+// validateSubjectAttributes validates that the allowed attributes of a policy
+// do not list attributes which have a dedicated field.
+func validateSubjectAttributes(attributes []policyapi.CertificateRequestPolicyAllowedSubjectAttribute, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	for i, attr := range attributes {
+		oid, err := utilpki.ParseObjectIdentifier(attr.OID)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i).Child("oid"), attr.OID, err.Error()))
+			continue
+		}
+		if isNamedSubjectAttribute(oid) {
+			el = append(el, field.Invalid(fldPath.Index(i).Child("oid"), attr.OID, "attribute has a dedicated field"))
+		}
+	}
+	return el
+}
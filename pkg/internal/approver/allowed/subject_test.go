@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"testing"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// literalSubject is a subject with attributes which can only be requested
+// through the literalSubject of a cert-manager Certificate.
+const literalSubject = "CN=foo,UID=jdoe,2.5.4.12=Engineer,1.2.840.113549.1.9.1=jdoe@example.com,2.5.4.4=Doe,DC=example,DC=com"
+
+// withLiteralSubject returns a CSR modifier which requests the given subject
+// string, in the same way as the literalSubject of a cert-manager Certificate.
+func withLiteralSubject(t *testing.T, subject string) gen.CSRModifier {
+	return func(csr *x509.CertificateRequest) error {
+		rdns, err := utilpki.UnmarshalSubjectStringToRDNSequence(subject)
+		require.NoError(t, err)
+		csr.RawSubject, err = asn1.Marshal(rdns)
+		return err
+	}
+}
+
+func Test_subjectEvaluator_values(t *testing.T) {
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(csrFrom(t, withLiteralSubject(t, literalSubject)))
+	require.NoError(t, err)
+
+	sub := evaluator{csr: csr, allowed: new(policyapi.CertificateRequestPolicyAllowed), fldPath: field.NewPath("spec", "allowed")}.Subject()
+	assert.Equal(t, []string{"com", "example"}, sub.values(utilpki.OIDConstants.DomainComponent))
+	assert.Equal(t, []string{"jdoe"}, sub.values(utilpki.OIDConstants.UniqueIdentifier))
+	assert.Equal(t, []string{"Engineer"}, sub.values(oidTitle))
+	assert.Equal(t, []string{"jdoe@example.com"}, sub.values(oidEmailAddress))
+	assert.Equal(t, []string{"2.5.4.4=Doe"}, sub.otherAttributes())
+	assert.Equal(t, []string{"foo"}, sub.values(utilpki.OIDConstants.CommonName))
+}
+
+func Test_Evaluate_RepeatedSubjectAttributes(t *testing.T) {
+	fldPath := field.NewPath("spec", "allowed")
+
+	tests := map[string]struct {
+		subject     string
+		expResponse approver.EvaluationResponse
+	}{
+		"if a single common name and serial number are allowed, return NotDenied": {
+			subject:     "CN=good,SERIALNUMBER=1234",
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if one of repeated common names is not allowed, return Denied": {
+			subject: "CN=good,CN=evil.example.org,SERIALNUMBER=1234",
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("commonName", "value"), "evil.example.org", "good"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if one of repeated serial numbers is not allowed, return Denied": {
+			subject: "CN=good,SERIALNUMBER=1234,SERIALNUMBER=5678",
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("subject", "serialNumber", "value"), "5678", "1234"),
+				}.ToAggregate().Error(),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, withLiteralSubject(t, test.subject))))
+			response, err := Approver().Evaluate(context.TODO(), &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					CommonName: &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("good")},
+					Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
+						SerialNumber:          &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("1234")},
+						DenyUnknownAttributes: ptr.To(true),
+					},
+				},
+			}}, request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
+
+func Test_Evaluate_SubjectAttributes(t *testing.T) {
+	request := gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, withLiteralSubject(t, literalSubject))))
+
+	allowedSubject := func() *policyapi.CertificateRequestPolicyAllowedX509Subject {
+		return &policyapi.CertificateRequestPolicyAllowedX509Subject{
+			DomainComponents: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"example", "com"}},
+			UserIDs:          &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}},
+			Titles:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"Engineer"}},
+			EmailAddresses:   &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*@example.com"}},
+		}
+	}
+	fldPath := field.NewPath("spec", "allowed", "subject")
+
+	tests := map[string]struct {
+		subject     func(*policyapi.CertificateRequestPolicyAllowedX509Subject)
+		expResponse approver.EvaluationResponse
+	}{
+		"if all subject attributes are allowed, return NotDenied": {
+			subject:     func(*policyapi.CertificateRequestPolicyAllowedX509Subject) {},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if named subject attributes are omitted, return Denied": {
+			subject: func(sub *policyapi.CertificateRequestPolicyAllowedX509Subject) {
+				sub.DomainComponents = nil
+				sub.Titles = nil
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("domainComponents"), []string{"com", "example"}, "no allowed values"),
+					field.Invalid(fldPath.Child("titles"), []string{"Engineer"}, "no allowed values"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if named subject attribute does not match values, return Denied": {
+			subject: func(sub *policyapi.CertificateRequestPolicyAllowedX509Subject) {
+				sub.EmailAddresses.Values = &[]string{"*@example.net"}
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("emailAddresses", "values"), []string{"jdoe@example.com"}, "*@example.net"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if unknown subject attributes are denied, return Denied": {
+			subject: func(sub *policyapi.CertificateRequestPolicyAllowedX509Subject) {
+				sub.DenyUnknownAttributes = ptr.To(true)
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("attributes"), []string{"2.5.4.4"}, "no allowed subject attribute types"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if unknown subject attributes are denied but the attribute is listed, return NotDenied": {
+			subject: func(sub *policyapi.CertificateRequestPolicyAllowedX509Subject) {
+				sub.DenyUnknownAttributes = ptr.To(true)
+				sub.Attributes = []policyapi.CertificateRequestPolicyAllowedSubjectAttribute{
+					{OID: "2.5.4.4", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"D*"}}},
+				}
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if listed subject attribute does not match values, return Denied": {
+			subject: func(sub *policyapi.CertificateRequestPolicyAllowedX509Subject) {
+				sub.Attributes = []policyapi.CertificateRequestPolicyAllowedSubjectAttribute{
+					{OID: "2.5.4.4", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"Smith"}}},
+				}
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(fldPath.Child("attributes").Index(0).Child("values"), []string{"Doe"}, "Smith"),
+				}.ToAggregate().Error(),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sub := allowedSubject()
+			test.subject(sub)
+			response, err := Approver().Evaluate(context.TODO(), &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Allowed: &policyapi.CertificateRequestPolicyAllowed{
+					CommonName: &policyapi.CertificateRequestPolicyAllowedString{Value: ptr.To("foo")},
+					Subject:    sub,
+				},
+			}}, request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
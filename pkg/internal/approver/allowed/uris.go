@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+)
+
+// uriSlice returns the string slice of the allowed URIs, or nil if URIs are
+// not allowed.
+func uriSlice(uris *policyapi.CertificateRequestPolicyAllowedURIs) *policyapi.CertificateRequestPolicyAllowedStringSlice {
+	if uris == nil {
+		return nil
+	}
+	return &uris.CertificateRequestPolicyAllowedStringSlice
+}
+
+// urisDefined returns whether the policy defines what URIs are allowed.
+func urisDefined(uris *policyapi.CertificateRequestPolicyAllowedURIs) bool {
+	return sliceDefined(uriSlice(uris)) || (uris != nil && len(uris.Matchers) > 0)
+}
+
+func (e evaluator) URIs(values []string) field.ErrorList {
+	var (
+		uris    = e.allowed.URIs
+		fldPath = e.fldPath.Child("uris")
+	)
+
+	if uris == nil || len(uris.Matchers) == 0 {
+		return e.a.evaluateSlice(e.cr, values, uriSlice(uris), fldPath)
+	}
+
+	// Matchers define the allowed URIs, so values and validations are only
+	// evaluated if they are defined, or to check that a required field is set.
+	var el field.ErrorList
+	if len(values) == 0 || sliceDefined(uriSlice(uris)) {
+		el = e.a.evaluateSlice(e.cr, values, uriSlice(uris), fldPath)
+	}
+
+	var unmatched []string
+	for _, value := range values {
+		if !uriMatchesAny(uris.Matchers, value, e.deny) {
+			unmatched = append(unmatched, value)
+		}
+	}
+	if len(unmatched) > 0 {
+		el = append(el, field.Invalid(fldPath.Child("matchers"), unmatched, "no matching URI matcher"))
+	}
+
+	return el
+}
+
+// uriMatchesAny returns whether the URI matches at least one of the matchers.
+// URIs which cannot be parsed never match an Allow policy, and always match a
+// Deny policy, which is the case if deny is true.
+func uriMatchesAny(matchers []policyapi.CertificateRequestPolicyAllowedURIMatcher, value string, deny bool) bool {
+	uri, err := url.Parse(value)
+	if err != nil {
+		return deny
+	}
+
+	for _, matcher := range matchers {
+		if uriMatches(matcher, uri, deny) {
+			return true
+		}
+	}
+	return false
+}
+
+// uriMatches returns whether the parsed URI matches the matcher. If deny is
+// true, the matcher is of a Deny policy. Deny policies match URIs regardless
+// of their userinfo, query and fragment, match a host which cannot be
+// normalised, and match a path which is not clean, so that such URIs can't be
+// used to avoid them.
+func uriMatches(matcher policyapi.CertificateRequestPolicyAllowedURIMatcher, uri *url.URL, deny bool) bool {
+	if !strings.EqualFold(uri.Scheme, matcher.Scheme) {
+		return false
+	}
+
+	if !deny {
+		if uri.User != nil && !ptr.Deref(matcher.AllowUserinfo, false) {
+			return false
+		}
+		if (uri.RawQuery != "" || uri.ForceQuery) && !ptr.Deref(matcher.AllowQuery, false) {
+			return false
+		}
+		if uri.Fragment != "" && !ptr.Deref(matcher.AllowFragment, false) {
+			return false
+		}
+	}
+
+	if matcher.Host != nil {
+		if ok, err := util.DNSMatches(*matcher.Host, uri.Host); !ok && (err == nil || !deny) {
+			return false
+		}
+	}
+
+	uriPath, separator := uri.Opaque, ":"
+	if len(uriPath) == 0 {
+		uriPath, separator = uri.Path, "/"
+		if !cleanURIPath(uri) {
+			return deny
+		}
+	}
+
+	if matcher.PathPrefix != nil && !pathPrefixMatches(*matcher.PathPrefix, uriPath, separator) {
+		return false
+	}
+	if matcher.Path != nil && !util.URIPathMatches(*matcher.Path, uriPath) {
+		return false
+	}
+
+	return true
+}
+
+// pathPrefixMatches returns whether the path starts with the prefix on a
+// segment boundary, so that the prefix "/ns/sandbox" matches "/ns/sandbox"
+// and "/ns/sandbox/sa/foo" but not "/ns/sandboxevil".
+func pathPrefixMatches(prefix, uriPath, separator string) bool {
+	if !strings.HasPrefix(uriPath, prefix) {
+		return false
+	}
+	return len(uriPath) == len(prefix) ||
+		len(prefix) == 0 ||
+		strings.HasSuffix(prefix, separator) ||
+		strings.HasPrefix(uriPath[len(prefix):], separator)
+}
+
+// cleanURIPath returns whether the path of the URI is clean: it contains no
+// "." or ".." segments, no empty segments other than a trailing slash, and no
+// escaped "/" which could be mistaken for a segment separator.
+func cleanURIPath(uri *url.URL) bool {
+	if len(uri.Path) == 0 {
+		return true
+	}
+	if strings.Contains(strings.ToLower(uri.EscapedPath()), "%2f") {
+		return false
+	}
+
+	clean := path.Clean(uri.Path)
+	if strings.HasSuffix(uri.Path, "/") && clean != "/" {
+		clean += "/"
+	}
+	return clean == uri.Path
+}
+
+// validateURIs validates the patterns of the allowed URI matchers.
+func validateURIs(uris *policyapi.CertificateRequestPolicyAllowedURIs, fldPath *field.Path) field.ErrorList {
+	if uris == nil {
+		return nil
+	}
+
+	var el field.ErrorList
+	for i, matcher := range uris.Matchers {
+		matcherPath := fldPath.Child("matchers").Index(i)
+
+		if len(matcher.Scheme) == 0 {
+			el = append(el, field.Required(matcherPath.Child("scheme"), "must be defined"))
+		}
+		if matcher.Host != nil {
+			if err := util.ValidateDNSPattern(*matcher.Host); err != nil {
+				el = append(el, field.Invalid(matcherPath.Child("host"), *matcher.Host, err.Error()))
+			}
+		}
+		if matcher.Path != nil {
+			if err := util.ValidateURIPathPattern(*matcher.Path); err != nil {
+				el = append(el, field.Invalid(matcherPath.Child("path"), *matcher.Path, err.Error()))
+			}
+		}
+	}
+	return el
+}
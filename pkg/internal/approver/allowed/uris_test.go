@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowed
+
+import (
+	"testing"
+
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
+)
+
+func Test_uriMatchesAny(t *testing.T) {
+	spiffe := policyapi.CertificateRequestPolicyAllowedURIMatcher{
+		Scheme: "spiffe",
+		Host:   ptr.To("cluster.local"),
+		Path:   ptr.To("/ns/*/sa/*"),
+	}
+
+	tests := map[string]struct {
+		matcher policyapi.CertificateRequestPolicyAllowedURIMatcher
+		deny    bool
+		value   string
+		exp     bool
+	}{
+		"matching SPIFFE ID": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo/sa/bar", exp: true,
+		},
+		"scheme and host are matched case-insensitively": {
+			matcher: spiffe, value: "SPIFFE://Cluster.Local/ns/foo/sa/bar", exp: true,
+		},
+		"different scheme": {
+			matcher: spiffe, value: "https://cluster.local/ns/foo/sa/bar", exp: false,
+		},
+		"different host": {
+			matcher: spiffe, value: "spiffe://evil.local/ns/foo/sa/bar", exp: false,
+		},
+		"host with port": {
+			matcher: spiffe, value: "spiffe://cluster.local:8443/ns/foo/sa/bar", exp: false,
+		},
+		"host injected as userinfo": {
+			matcher: spiffe, value: "spiffe://cluster.local@evil.local/ns/foo/sa/bar", exp: false,
+		},
+		"userinfo not allowed": {
+			matcher: spiffe, value: "spiffe://user@cluster.local/ns/foo/sa/bar", exp: false,
+		},
+		"userinfo allowed": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", AllowUserinfo: ptr.To(true)},
+			value:   "spiffe://user@cluster.local/ns/foo/sa/bar", exp: true,
+		},
+		"query not allowed": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo/sa/bar?a=b", exp: false,
+		},
+		"empty query not allowed": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo/sa/bar?", exp: false,
+		},
+		"query allowed": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", AllowQuery: ptr.To(true)},
+			value:   "spiffe://cluster.local/ns/foo/sa/bar?a=b", exp: true,
+		},
+		"fragment not allowed": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo/sa/bar#baz", exp: false,
+		},
+		"fragment allowed": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", AllowFragment: ptr.To(true)},
+			value:   "spiffe://cluster.local/ns/foo/sa/bar#baz", exp: true,
+		},
+		"path with extra segments": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo/sa/bar/baz", exp: false,
+		},
+		"path with dot-dot segment": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/foo/")},
+			value:   "spiffe://cluster.local/ns/foo/../bar/sa/baz", exp: false,
+		},
+		"path with empty segment": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/foo/")},
+			value:   "spiffe://cluster.local/ns/foo//sa/bar", exp: false,
+		},
+		"path with escaped slash": {
+			matcher: spiffe, value: "spiffe://cluster.local/ns/foo%2Fbar/sa/baz", exp: false,
+		},
+		"path with trailing slash matching prefix": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "https", PathPrefix: ptr.To("/api/")},
+			value:   "https://example.com/api/", exp: true,
+		},
+		"path prefix not matching": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/foo/")},
+			value:   "spiffe://cluster.local/ns/foobar/sa/baz", exp: false,
+		},
+		"path prefix without trailing slash matching segment": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/sandbox")},
+			value:   "spiffe://cluster.local/ns/sandbox/sa/baz", exp: true,
+		},
+		"path prefix without trailing slash matching whole path": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/sandbox")},
+			value:   "spiffe://cluster.local/ns/sandbox", exp: true,
+		},
+		"path prefix without trailing slash not matching within segment": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/sandbox")},
+			value:   "spiffe://cluster.local/ns/sandboxevil/sa/baz", exp: false,
+		},
+		"wildcard host": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "https", Host: ptr.To("*.example.com")},
+			value:   "https://api.example.com/", exp: true,
+		},
+		"host required by pattern": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", Host: ptr.To("*")},
+			value:   "spiffe:///ns/foo", exp: false,
+		},
+		"opaque URI matched by prefix": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "urn", PathPrefix: ptr.To("uuid:")},
+			value:   "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66", exp: true,
+		},
+		"opaque URI matched by prefix without trailing separator": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "urn", PathPrefix: ptr.To("uuid")},
+			value:   "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66", exp: true,
+		},
+		"opaque URI not matched by prefix within segment": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "urn", PathPrefix: ptr.To("uu")},
+			value:   "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66", exp: false,
+		},
+		"host which cannot be normalised": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "https", Host: ptr.To("*.corp.internal")},
+			value:   "https://xn--a.corp.internal/", exp: false,
+		},
+		"host which cannot be normalised matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "https", Host: ptr.To("*.corp.internal")},
+			deny:    true,
+			value:   "https://xn--a.corp.internal/", exp: true,
+		},
+		"path with dot segment matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/prod")},
+			deny:    true,
+			value:   "spiffe://td/ns/./prod/sa/x", exp: true,
+		},
+		"path with empty segment matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/prod")},
+			deny:    true,
+			value:   "spiffe://td/ns//prod/sa/x", exp: true,
+		},
+		"path with escaped slash matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/prod")},
+			deny:    true,
+			value:   "spiffe://td/ns%2Fprod/sa/x", exp: true,
+		},
+		"clean path not matching Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/prod")},
+			deny:    true,
+			value:   "spiffe://td/ns/dev/sa/x", exp: false,
+		},
+		"userinfo, query and fragment matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe", PathPrefix: ptr.To("/ns/prod")},
+			deny:    true,
+			value:   "spiffe://user@td/ns/prod/sa/x?a=b#c", exp: true,
+		},
+		"unparsable URI matched by Deny policy": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe"},
+			deny:    true,
+			value:   "spiffe://cluster.local/%zz", exp: true,
+		},
+		"unparsable URI": {
+			matcher: policyapi.CertificateRequestPolicyAllowedURIMatcher{Scheme: "spiffe"},
+			value:   "spiffe://cluster.local/%zz", exp: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, uriMatchesAny([]policyapi.CertificateRequestPolicyAllowedURIMatcher{test.matcher}, test.value, test.deny))
+		})
+	}
+}
+
+func Test_URIs(t *testing.T) {
+	fldPath := field.NewPath("spec", "allowed", "uris")
+
+	spiffe := []policyapi.CertificateRequestPolicyAllowedURIMatcher{{
+		Scheme: "spiffe",
+		Host:   ptr.To("cluster.local"),
+		Path:   ptr.To("/ns/*/sa/*"),
+	}}
+
+	tests := map[string]struct {
+		values  []string
+		allowed *policyapi.CertificateRequestPolicyAllowedURIs
+		expErrs field.ErrorList
+	}{
+		"if URIs requested but none allowed, return error": {
+			values:  []string{"spiffe://cluster.local/ns/foo/sa/bar"},
+			expErrs: field.ErrorList{field.Invalid(fldPath, []string{"spiffe://cluster.local/ns/foo/sa/bar"}, "no allowed values")},
+		},
+		"if URIs requested matching matchers, return no errors": {
+			values:  []string{"spiffe://cluster.local/ns/foo/sa/bar"},
+			allowed: &policyapi.CertificateRequestPolicyAllowedURIs{Matchers: spiffe},
+			expErrs: nil,
+		},
+		"if URIs requested not matching matchers, return error": {
+			values:  []string{"spiffe://cluster.local/ns/foo/sa/bar", "spiffe://cluster.local@evil.local/ns/foo/sa/bar"},
+			allowed: &policyapi.CertificateRequestPolicyAllowedURIs{Matchers: spiffe},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Child("matchers"), []string{"spiffe://cluster.local@evil.local/ns/foo/sa/bar"}, "no matching URI matcher")},
+		},
+		"if URIs requested matching matchers but failing validations, return error": {
+			values: []string{"spiffe://cluster.local/ns/foo/sa/bar"},
+			allowed: &policyapi.CertificateRequestPolicyAllowedURIs{
+				CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{
+					Validations: []policyapi.ValidationRule{{Rule: "self.startsWith('spiffe://cluster.local/ns/' + cr.namespace + '/')", Message: ptr.To("must be in the namespace")}},
+				},
+				Matchers: spiffe,
+			},
+			expErrs: field.ErrorList{field.Invalid(fldPath.Child("validations").Index(0), "spiffe://cluster.local/ns/foo/sa/bar", "must be in the namespace")},
+		},
+		"if required URIs with matchers not requested, return error": {
+			allowed: &policyapi.CertificateRequestPolicyAllowedURIs{
+				CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true)},
+				Matchers: spiffe,
+			},
+			expErrs: field.ErrorList{field.Required(fldPath.Child("required"), "true")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := evaluator{
+				a:       allowed{validators: validation.NewCache()},
+				request: gen.CertificateRequest("", gen.SetCertificateRequestNamespace("admin")),
+				allowed: &policyapi.CertificateRequestPolicyAllowed{URIs: test.allowed},
+				fldPath: field.NewPath("spec", "allowed"),
+			}
+			assert.Equal(t, test.expErrs, e.URIs(test.values))
+		})
+	}
+}
@@ -18,30 +18,49 @@ package allowed
 
 import (
 	"context"
+	"net/netip"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 // Validate validates that the processed CertificateRequestPolicy has valid
-// allowed fields defined and there are no parsing errors in the values.
+// allowed fields and validations defined and there are no parsing errors in
+// the values.
 func (a allowed) Validate(_ context.Context, policy *policyapi.CertificateRequestPolicy) (approver.WebhookValidationResponse, error) {
+	var el field.ErrorList
+
+	for i, validation := range policy.Spec.Validations {
+		if _, err := a.validators.GetRequest(validation.Rule); err != nil {
+			el = append(el, field.Invalid(validationsPath.Index(i), validation.Rule, err.Error()))
+		}
+	}
+
 	// If no allowed fields are defined we can exit early
 	if policy.Spec.Allowed == nil {
 		return approver.WebhookValidationResponse{
-			Allowed: true,
-			Errors:  nil,
+			Allowed: len(el) == 0,
+			Errors:  el,
 		}, nil
 	}
 
 	var (
-		el      field.ErrorList
 		allowed = policy.Spec.Allowed
 		fldPath = field.NewPath("spec", "allowed")
 	)
 
+	uris := uriSlice(allowed.URIs)
+	if uris != nil && len(allowed.URIs.Matchers) > 0 {
+		// Matchers also define the allowed URIs of a required field.
+		uris = ptr.To(*uris)
+		uris.Required = nil
+	}
+
 	type stringSlicePair struct {
 		path  *field.Path
 		slice *policyapi.CertificateRequestPolicyAllowedStringSlice
@@ -49,7 +68,7 @@ func (a allowed) Validate(_ context.Context, policy *policyapi.CertificateReques
 	stringSlices := []stringSlicePair{
 		{fldPath.Child("dnsNames"), allowed.DNSNames},
 		{fldPath.Child("ipAddresses"), allowed.IPAddresses},
-		{fldPath.Child("uris"), allowed.URIs},
+		{fldPath.Child("uris"), uris},
 		{fldPath.Child("emailAddresses"), allowed.EmailAddresses},
 	}
 
@@ -71,10 +90,26 @@ func (a allowed) Validate(_ context.Context, policy *policyapi.CertificateReques
 		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("provinces"), allowedSub.Provinces})
 		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("streetAddresses"), allowedSub.StreetAddresses})
 		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("postalCodes"), allowedSub.PostalCodes})
+		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("domainComponents"), allowedSub.DomainComponents})
+		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("userIDs"), allowedSub.UserIDs})
+		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("titles"), allowedSub.Titles})
+		stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("emailAddresses"), allowedSub.EmailAddresses})
+		for i := range allowedSub.Attributes {
+			stringSlices = append(stringSlices, stringSlicePair{fldPathSub.Child("attributes").Index(i), &allowedSub.Attributes[i].CertificateRequestPolicyAllowedStringSlice})
+		}
+		el = append(el, validateSubjectAttributes(allowedSub.Attributes, fldPathSub.Child("attributes"))...)
 
 		strings = append(strings, stringPair{fldPathSub.Child("serialNumber"), allowedSub.SerialNumber})
 	}
 
+	el = append(el, validateDNSNames(allowed, fldPath)...)
+	el = append(el, validateIPAddresses(allowed.IPAddresses, fldPath.Child("ipAddresses"))...)
+	el = append(el, validateURIs(allowed.URIs, fldPath.Child("uris"))...)
+
+	for i := range allowed.OtherNames {
+		stringSlices = append(stringSlices, stringSlicePair{fldPath.Child("otherNames").Index(i), &allowed.OtherNames[i].CertificateRequestPolicyAllowedStringSlice})
+	}
+
 	for _, stringSlice := range stringSlices {
 		if stringSlice.slice != nil {
 			if stringSlice.slice.Required != nil && *stringSlice.slice.Required {
@@ -105,8 +140,67 @@ func (a allowed) Validate(_ context.Context, policy *policyapi.CertificateReques
 		}
 	}
 
+	el = append(el, a.validateExtensions(allowed.Extensions, fldPath.Child("extensions"))...)
+
 	return approver.WebhookValidationResponse{
 		Allowed: len(el) == 0,
 		Errors:  el,
 	}, nil
 }
+
+// validateDNSNames validates the DNS name match mode, and that the values of
+// the allowed DNS names are valid DNS patterns when they are matched in DNS
+// mode.
+func validateDNSNames(allowed *policyapi.CertificateRequestPolicyAllowed, fldPath *field.Path) field.ErrorList {
+	if allowed.DNSNameMatching == nil {
+		return nil
+	}
+
+	switch mode := allowed.DNSNameMatching.Mode; mode {
+	case "", policyapi.CertificateRequestPolicyMatchModeGlob:
+		return nil
+	case policyapi.CertificateRequestPolicyMatchModeDNS:
+	default:
+		return field.ErrorList{field.NotSupported(fldPath.Child("dnsNameMatching", "mode"), mode, []string{
+			string(policyapi.CertificateRequestPolicyMatchModeGlob), string(policyapi.CertificateRequestPolicyMatchModeDNS),
+		})}
+	}
+
+	if allowed.DNSNames == nil || allowed.DNSNames.Values == nil {
+		return nil
+	}
+
+	var el field.ErrorList
+	for i, value := range *allowed.DNSNames.Values {
+		if err := util.ValidateDNSPattern(value); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("dnsNames", "values").Index(i), value, err.Error()))
+		}
+	}
+	return el
+}
+
+// validateIPAddresses validates that the CIDR and IP address values of the
+// allowed IP addresses can be parsed. Values containing wildcards, which are
+// not CIDRs, are matched as strings and so are not validated.
+func validateIPAddresses(ipAddresses *policyapi.CertificateRequestPolicyAllowedStringSlice, fldPath *field.Path) field.ErrorList {
+	if ipAddresses == nil || ipAddresses.Values == nil {
+		return nil
+	}
+
+	var el field.ErrorList
+	for i, value := range *ipAddresses.Values {
+		var err error
+		switch {
+		case strings.Contains(value, "/"):
+			_, err = util.ParseCIDR(value)
+		case strings.Contains(value, "*"):
+			continue
+		default:
+			_, err = netip.ParseAddr(value)
+		}
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("values").Index(i), value, err.Error()))
+		}
+	}
+	return el
+}
@@ -51,7 +51,7 @@ func Test_Validate(t *testing.T) {
 						CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Required: ptr.To(true), Value: nil},
 						DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil},
 						IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil},
-						URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil},
+						URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil}},
 						EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil},
 						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 							Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: nil},
@@ -92,7 +92,7 @@ func Test_Validate(t *testing.T) {
 						CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Required: ptr.To(true), Value: ptr.To("")},
 						DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
 						IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
-						URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
+						URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}}},
 						EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
 						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 							Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(false), Values: &[]string{}},
@@ -119,7 +119,7 @@ func Test_Validate(t *testing.T) {
 						CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Required: ptr.To(true), Value: ptr.To("")},
 						DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
 						IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
-						URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
+						URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}}},
 						EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
 						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 							Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true), Values: &[]string{}},
@@ -146,7 +146,7 @@ func Test_Validate(t *testing.T) {
 						CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Validations: []policyapi.ValidationRule{{Rule: "cel"}}},
 						DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self > 2"}}},
 						IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self && false"}}},
-						URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.exists(x, p)"}}},
+						URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.exists(x, p)"}}}},
 						EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self"}}},
 						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 							Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self == '"}}},
@@ -180,6 +180,174 @@ func Test_Validate(t *testing.T) {
 				},
 			},
 		},
+		"if policy contains invalid top-level CEL validations, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Validations: []policyapi.ValidationRule{
+						{Rule: "cr.csr.subject.commonName in cr.csr.dnsNames"},
+						{Rule: "self.size() > 2"},
+						{Rule: "cr.name"},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(field.NewPath("spec.validations[1]"), "self.size() > 2", "ERROR: <input>:1:1: undeclared reference to 'self' (in container '')\n | self.size() > 2\n | ^"),
+					field.Invalid(field.NewPath("spec.validations[2]"), "cr.name", "got string, wanted bool result type"),
+				},
+			},
+		},
+		"if policy contains invalid DNS name patterns in DNS mode, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com", "**.example.com", "*example.com"}},
+						DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: policyapi.CertificateRequestPolicyMatchModeDNS},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.dnsNames.values[2]"), "*example.com", `wildcard must be an entire label: "*example"`),
+				},
+			},
+		},
+		"if policy contains an unsupported DNS name match mode, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						DNSNames:        &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*example.com"}},
+						DNSNameMatching: &policyapi.CertificateRequestPolicyAllowedDNSNameMatching{Mode: "Regex"},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.NotSupported(field.NewPath("spec.allowed.dnsNameMatching.mode"), policyapi.CertificateRequestPolicyMatchMode("Regex"), []string{"Glob", "DNS"}),
+				},
+			},
+		},
+		"if policy contains invalid IP address CIDRs or addresses, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						IPAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{
+							"10.20.0.0/16", "10.20.0.0/33", "10.*/8", "10.0.*", "10.0.0.1", "fd00::1", "10.0.0.256", "localhost",
+						}},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.ipAddresses.values[1]"), "10.20.0.0/33", `netip.ParsePrefix("10.20.0.0/33"): prefix length out of range`),
+					field.Invalid(field.NewPath("spec.allowed.ipAddresses.values[2]"), "10.*/8", `netip.ParsePrefix("10.*/8"): ParseAddr("10.*"): unexpected character (at "*")`),
+					field.Invalid(field.NewPath("spec.allowed.ipAddresses.values[6]"), "10.0.0.256", `ParseAddr("10.0.0.256"): IPv4 field has value >255`),
+					field.Invalid(field.NewPath("spec.allowed.ipAddresses.values[7]"), "localhost", `ParseAddr("localhost"): unable to parse IP`),
+				},
+			},
+		},
+		"if policy contains required URIs with matchers, expect an Allowed=true response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						URIs: &policyapi.CertificateRequestPolicyAllowedURIs{
+							CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true)},
+							Matchers: []policyapi.CertificateRequestPolicyAllowedURIMatcher{{Scheme: "spiffe", Host: ptr.To("cluster.local"), Path: ptr.To("/ns/*/sa/*")}},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: true,
+			},
+		},
+		"if policy contains invalid URI matchers, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						URIs: &policyapi.CertificateRequestPolicyAllowedURIs{
+							Matchers: []policyapi.CertificateRequestPolicyAllowedURIMatcher{
+								{Scheme: "spiffe", Host: ptr.To("cluster.local"), Path: ptr.To("/ns/*/sa/*")},
+								{Scheme: "", Host: ptr.To("*cluster.local"), Path: ptr.To("/ns/foo-*")},
+							},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Required(field.NewPath("spec.allowed.uris.matchers[1].scheme"), "must be defined"),
+					field.Invalid(field.NewPath("spec.allowed.uris.matchers[1].host"), "*cluster.local", `wildcard must be an entire label: "*cluster"`),
+					field.Invalid(field.NewPath("spec.allowed.uris.matchers[1].path"), "/ns/foo-*", `wildcard must be an entire path segment: "foo-*"`),
+				},
+			},
+		},
+		"if policy contains invalid subject attributes, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
+							Titles: &policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true)},
+							Attributes: []policyapi.CertificateRequestPolicyAllowedSubjectAttribute{
+								{OID: "2.5.4.4", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "cel"}}}},
+								{OID: "0.9.2342.19200300.100.1.25", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*"}}},
+							},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.subject.attributes[1].oid"), "0.9.2342.19200300.100.1.25", "attribute has a dedicated field"),
+					field.Required(field.NewPath("spec.allowed.subject.titles.values"), "at least one of 'values' or 'validations' must be defined if field is 'required'"),
+					field.Invalid(field.NewPath("spec.allowed.subject.attributes[0].validations[0]"), "cel", "ERROR: <input>:1:1: undeclared reference to 'cel' (in container '')\n | cel\n | ^"),
+				},
+			},
+		},
+		"if policy contains invalid otherNames, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						OtherNames: []policyapi.CertificateRequestPolicyAllowedOtherName{
+							{OID: "1.3.6.1.4.1.311.20.2.3", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Required: ptr.To(true)}},
+							{OID: "1.2.3.4", CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "cel"}}}},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Required(field.NewPath("spec.allowed.otherNames[0].values"), "at least one of 'values' or 'validations' must be defined if field is 'required'"),
+					field.Invalid(field.NewPath("spec.allowed.otherNames[1].validations[0]"), "cel", "ERROR: <input>:1:1: undeclared reference to 'cel' (in container '')\n | cel\n | ^"),
+				},
+			},
+		},
+		"if policy contains invalid extensions, expect an Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Allowed: &policyapi.CertificateRequestPolicyAllowed{
+						Extensions: []policyapi.CertificateRequestPolicyAllowedExtension{
+							{OID: "1.2.3.4", Values: []string{"0c03*", "0C03"}},
+							{OID: "1.2.3.5", Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}, {Rule: "cel"}}},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(field.NewPath("spec.allowed.extensions[0].values[1]"), "0C03", "must be lowercase hexadecimal, optionally containing wildcards"),
+					field.Invalid(field.NewPath("spec.allowed.extensions[1].validations[1]"), "cel", "ERROR: <input>:1:1: undeclared reference to 'cel' (in container '')\n | cel\n | ^"),
+				},
+			},
+		},
 		"if policy contains valid CEL validations, expect a Allowed=true response": {
 			policy: &policyapi.CertificateRequestPolicy{
 				Spec: policyapi.CertificateRequestPolicySpec{
@@ -187,7 +355,7 @@ func Test_Validate(t *testing.T) {
 						CommonName:     &policyapi.CertificateRequestPolicyAllowedString{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
 						DNSNames:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
 						IPAddresses:    &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
-						URIs:           &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
+						URIs:           &policyapi.CertificateRequestPolicyAllowedURIs{CertificateRequestPolicyAllowedStringSlice: policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}}},
 						EmailAddresses: &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
 						Subject: &policyapi.CertificateRequestPolicyAllowedX509Subject{
 							Organizations:       &policyapi.CertificateRequestPolicyAllowedStringSlice{Validations: []policyapi.ValidationRule{{Rule: "self.size() > 2"}}},
@@ -35,26 +35,35 @@ func init() {
 
 // Approver returns an instance on the constraints approver.
 func Approver() approver.Interface {
-	return constraints{}
+	return constraints{
+		weakKeys: new(weakKeys),
+	}
 }
 
 // constraints is a base approver-policy Approver that is responsible for
 // ensuring incoming requests satisfy the constraints defined on
 // CertificateRequestPolicies. It is expected that constraints must _always_ be
 // registered for all approver-policy builds.
-type constraints struct{}
+type constraints struct {
+	weakKeys *weakKeys
+}
 
 // Name of Approver is "constraints"
 func (c constraints) Name() string {
 	return "constraints"
 }
 
-// RegisterFlags is a no-op, constraints doesn't need any flags.
-func (c constraints) RegisterFlags(_ *pflag.FlagSet) {}
+// RegisterFlags registers the flag for the weak key blocklists.
+func (c constraints) RegisterFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.weakKeys.blocklistFiles, "constraints-weak-key-blocklist-files", nil,
+		"Paths of files listing the fingerprints of public keys which are rejected by policies "+
+			"with `constraints.privateKey.rejectWeakKeys`. Each line is either the SHA-256 "+
+			"fingerprint of a DER encoded SubjectPublicKeyInfo, or a Debian openssl-blacklist fingerprint.")
+}
 
-// Prepare is a no-op, constraints doesn't need to prepare anything.
+// Prepare loads the weak key blocklists.
 func (c constraints) Prepare(_ context.Context, _ logr.Logger, _ manager.Manager) error {
-	return nil
+	return c.weakKeys.load()
 }
 
 // Ready always returns ready, constraints doesn't have any dependencies to
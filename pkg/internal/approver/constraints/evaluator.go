@@ -22,14 +22,18 @@ import (
 	"crypto/ed25519"
 	"crypto/rsa"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 // Evaluate evaluates whether the given CertificateRequest satisfies the
@@ -70,15 +74,19 @@ func (c constraints) Evaluate(_ context.Context, policy *policyapi.CertificateRe
 		}
 	}
 
+	if consts.PrivateKey == nil && consts.SignatureAlgorithm == nil {
+		return evaluationResponse(el), nil
+	}
+
+	// Decode CSR from CertificateRequest
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(request.Spec.Request)
+	if err != nil {
+		return approver.EvaluationResponse{}, err
+	}
+
 	if consts.PrivateKey != nil {
 		fldPath := fldPath.Child("privateKey")
 
-		// Decode CSR from CertificateRequest
-		csr, err := utilpki.DecodeX509CertificateRequestBytes(request.Spec.Request)
-		if err != nil {
-			return approver.EvaluationResponse{}, err
-		}
-
 		alg, size, err := decodePublicKey(csr.PublicKey)
 		if err != nil {
 			return approver.EvaluationResponse{}, err
@@ -95,15 +103,59 @@ func (c constraints) Evaluate(_ context.Context, policy *policyapi.CertificateRe
 		if consts.PrivateKey.MinSize != nil && *consts.PrivateKey.MinSize > size {
 			el = append(el, field.Invalid(fldPath.Child("minSize"), strconv.Itoa(size), strconv.Itoa(*consts.PrivateKey.MinSize)))
 		}
+
+		switch pub := csr.PublicKey.(type) {
+		case *ecdsa.PublicKey:
+			if curve := pub.Curve.Params().Name; len(consts.PrivateKey.Curves) > 0 && !slices.Contains(consts.PrivateKey.Curves, curve) {
+				el = append(el, field.Invalid(fldPath.Child("curves"), curve, strings.Join(consts.PrivateKey.Curves, ", ")))
+			}
+
+		case *rsa.PublicKey:
+			if consts.PrivateKey.MaxRSAPublicExponent != nil && *consts.PrivateKey.MaxRSAPublicExponent < pub.E {
+				el = append(el, field.Invalid(fldPath.Child("maxRSAPublicExponent"), strconv.Itoa(pub.E), strconv.Itoa(*consts.PrivateKey.MaxRSAPublicExponent)))
+			}
+
+			if consts.PrivateKey.MinRSAPublicExponent != nil && *consts.PrivateKey.MinRSAPublicExponent > pub.E {
+				el = append(el, field.Invalid(fldPath.Child("minRSAPublicExponent"), strconv.Itoa(pub.E), strconv.Itoa(*consts.PrivateKey.MinRSAPublicExponent)))
+			}
+		}
+
+		if ptr.Deref(consts.PrivateKey.RejectWeakKeys, false) {
+			weakness, err := c.weakKeys.weakness(csr.PublicKey)
+			if err != nil {
+				return approver.EvaluationResponse{}, err
+			}
+			if len(weakness) > 0 {
+				el = append(el, field.Forbidden(fldPath.Child("rejectWeakKeys"), weakness))
+			}
+		}
 	}
 
+	if consts.SignatureAlgorithm != nil {
+		fldPath := fldPath.Child("signatureAlgorithm")
+		alg := csr.SignatureAlgorithm.String()
+
+		if allowed := consts.SignatureAlgorithm.Allowed; len(allowed) > 0 && !util.WildcardContains(allowed, alg) {
+			el = append(el, field.Invalid(fldPath.Child("allowed"), alg, strings.Join(allowed, ", ")))
+		}
+
+		if util.WildcardContains(consts.SignatureAlgorithm.Forbidden, alg) {
+			el = append(el, field.Forbidden(fldPath.Child("forbidden"), fmt.Sprintf("signature algorithm %s is forbidden", alg)))
+		}
+	}
+
+	return evaluationResponse(el), nil
+}
+
+// evaluationResponse returns the response for the given policy violations.
+func evaluationResponse(el field.ErrorList) approver.EvaluationResponse {
 	// If there are errors, then return not approved and the aggregated errors
 	if len(el) > 0 {
-		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error()}, nil
+		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error()}
 	}
 
 	// If no evaluation errors resulting from this policy, return not denied
-	return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	return approver.EvaluationResponse{Result: approver.ResultNotDenied}
 }
 
 // decodePublicKey will return the algorithm and size of the given public key.
@@ -57,6 +57,82 @@ func Test_Evaluate(t *testing.T) {
 			},
 			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: ""},
 		},
+		"if ECDSA CSR uses a curve which is not allowed, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.ECDSA))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{
+					PrivateKey: &policyapi.CertificateRequestPolicyConstraintsPrivateKey{
+						Curves: []string{"P-384", "P-521"},
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.constraints.privateKey.curves"), "P-256", "P-384, P-521"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if RSA CSR has a public exponent outside of the constraints, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.RSA))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{
+					PrivateKey: &policyapi.CertificateRequestPolicyConstraintsPrivateKey{
+						Curves:               []string{"P-384"},
+						MinRSAPublicExponent: ptr.To(65539),
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.constraints.privateKey.minRSAPublicExponent"), "65537", "65539"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if RSA CSR has a public exponent within the constraints, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.RSA))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{
+					PrivateKey: &policyapi.CertificateRequestPolicyConstraintsPrivateKey{
+						MinRSAPublicExponent: ptr.To(65537),
+						MaxRSAPublicExponent: ptr.To(65537),
+						RejectWeakKeys:       ptr.To(true),
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if CSR signature algorithm is not allowed and forbidden, return Denied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.RSA))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{
+					SignatureAlgorithm: &policyapi.CertificateRequestPolicyConstraintsSignatureAlgorithm{
+						Allowed:   []string{"ECDSA-*", "*-RSAPSS"},
+						Forbidden: []string{"*SHA1*", "*-RSA"},
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Invalid(field.NewPath("spec.constraints.signatureAlgorithm.allowed"), "SHA256-RSA", "ECDSA-*, *-RSAPSS"),
+					field.Forbidden(field.NewPath("spec.constraints.signatureAlgorithm.forbidden"), "signature algorithm SHA256-RSA is forbidden"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if CSR signature algorithm is allowed, return NotDenied": {
+			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.ECDSA))),
+			policy: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{
+					SignatureAlgorithm: &policyapi.CertificateRequestPolicyConstraintsSignatureAlgorithm{
+						Allowed:   []string{"ECDSA-*", "*-RSAPSS"},
+						Forbidden: []string{"*SHA1*", "*-RSA"},
+					},
+				},
+			},
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
 		"if no constraints defined, should return NotDenied": {
 			request: gen.CertificateRequest("", gen.SetCertificateRequestCSR(csrFrom(t, x509.ECDSA))),
 			policy: policyapi.CertificateRequestPolicySpec{
@@ -18,13 +18,32 @@ package constraints
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
+	"slices"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+)
+
+var (
+	// supportedCurves are the named curves of ECDSA keys which may be
+	// constrained.
+	supportedCurves = []string{"P-224", "P-256", "P-384", "P-521"}
+
+	// signatureAlgorithms are the names of the known CSR signature
+	// algorithms.
+	signatureAlgorithms = func() []string {
+		var algs []string
+		for alg := x509.MD2WithRSA; alg <= x509.PureEd25519; alg++ {
+			algs = append(algs, alg.String())
+		}
+		return algs
+	}()
 )
 
 // Validate validates that the processed CertificateRequestPolicy has valid
@@ -78,6 +97,32 @@ func (c constraints) Validate(_ context.Context, policy *policyapi.CertificateRe
 		if maxSize != nil && minSize != nil && *maxSize < *minSize {
 			el = append(el, field.Invalid(fldPath.Child("maxSize"), *maxSize, "maxSize must be the same value as minSize or larger"))
 		}
+
+		for i, curve := range consts.PrivateKey.Curves {
+			if !slices.Contains(supportedCurves, curve) {
+				el = append(el, field.NotSupported(fldPath.Child("curves").Index(i), curve, supportedCurves))
+			}
+		}
+
+		maxExponent := consts.PrivateKey.MaxRSAPublicExponent
+		if maxExponent != nil && *maxExponent < 3 {
+			el = append(el, field.Invalid(fldPath.Child("maxRSAPublicExponent"), *maxExponent, "must be 3 or larger"))
+		}
+
+		minExponent := consts.PrivateKey.MinRSAPublicExponent
+		if minExponent != nil && *minExponent < 3 {
+			el = append(el, field.Invalid(fldPath.Child("minRSAPublicExponent"), *minExponent, "must be 3 or larger"))
+		}
+
+		if maxExponent != nil && minExponent != nil && *maxExponent < *minExponent {
+			el = append(el, field.Invalid(fldPath.Child("maxRSAPublicExponent"), *maxExponent, "maxRSAPublicExponent must be the same value as minRSAPublicExponent or larger"))
+		}
+	}
+
+	if consts.SignatureAlgorithm != nil {
+		fldPath := fldPath.Child("signatureAlgorithm")
+		el = append(el, validateSignatureAlgorithms(consts.SignatureAlgorithm.Allowed, fldPath.Child("allowed"))...)
+		el = append(el, validateSignatureAlgorithms(consts.SignatureAlgorithm.Forbidden, fldPath.Child("forbidden"))...)
 	}
 
 	if consts.MaxDuration != nil && consts.MinDuration != nil && consts.MaxDuration.Duration < consts.MinDuration.Duration {
@@ -95,3 +140,17 @@ func (c constraints) Validate(_ context.Context, policy *policyapi.CertificateRe
 		Errors:  el,
 	}, nil
 }
+
+// validateSignatureAlgorithms validates that each signature algorithm value
+// matches at least one known signature algorithm.
+func validateSignatureAlgorithms(values []string, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	for i, value := range values {
+		if !slices.ContainsFunc(signatureAlgorithms, func(alg string) bool {
+			return util.WildcardMatches(value, alg)
+		}) {
+			el = append(el, field.Invalid(fldPath.Index(i), value, "does not match any known signature algorithm"))
+		}
+	}
+	return el
+}
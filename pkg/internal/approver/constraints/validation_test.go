@@ -98,6 +98,33 @@ func Test_Validate(t *testing.T) {
 				},
 			},
 		},
+		"if policy contains invalid curves, exponents and signature algorithms, expect a Allowed=false response": {
+			policy: &policyapi.CertificateRequestPolicy{
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Constraints: &policyapi.CertificateRequestPolicyConstraints{
+						PrivateKey: &policyapi.CertificateRequestPolicyConstraintsPrivateKey{
+							Curves:               []string{"P-256", "secp256k1"},
+							MinRSAPublicExponent: ptr.To(65537),
+							MaxRSAPublicExponent: ptr.To(1),
+						},
+						SignatureAlgorithm: &policyapi.CertificateRequestPolicyConstraintsSignatureAlgorithm{
+							Allowed:   []string{"ECDSA-*", "SHA256-RSA-PSS"},
+							Forbidden: []string{"*SHA1*", "MD4-RSA"},
+						},
+					},
+				},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.NotSupported(field.NewPath("spec.constraints.privateKey.curves[1]"), "secp256k1", []string{"P-224", "P-256", "P-384", "P-521"}),
+					field.Invalid(field.NewPath("spec.constraints.privateKey.maxRSAPublicExponent"), 1, "must be 3 or larger"),
+					field.Invalid(field.NewPath("spec.constraints.privateKey.maxRSAPublicExponent"), 1, "maxRSAPublicExponent must be the same value as minRSAPublicExponent or larger"),
+					field.Invalid(field.NewPath("spec.constraints.signatureAlgorithm.allowed[1]"), "SHA256-RSA-PSS", "does not match any known signature algorithm"),
+					field.Invalid(field.NewPath("spec.constraints.signatureAlgorithm.forbidden[1]"), "MD4-RSA", "does not match any known signature algorithm"),
+				},
+			},
+		},
 		"if policy contains no validation errors, expect a Allowed=true response": {
 			policy: &policyapi.CertificateRequestPolicy{
 				Spec: policyapi.CertificateRequestPolicySpec{
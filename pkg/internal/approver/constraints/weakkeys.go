@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constraints
+
+import (
+	"bufio"
+	"crypto/rsa"
+	"crypto/sha1" // #nosec G505 -- used to match Debian blocklist fingerprints, not for security
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+var (
+	// rocaPrimes are the primes used to fingerprint RSA moduli generated by
+	// the vulnerable Infineon RSALib (ROCA, CVE-2017-15361). The primes of
+	// such moduli are of the form k*M + (65537^a mod M), where M is the
+	// product of small primes, so the modulus is a power of 65537 modulo
+	// each of these primes.
+	rocaPrimes = []int64{
+		3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71,
+		73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139, 149,
+		151, 157, 163, 167,
+	}
+
+	// rocaGenerators are the residues of the powers of 65537 modulo each of
+	// the rocaPrimes.
+	rocaGenerators = func() []map[int64]bool {
+		generators := make([]map[int64]bool, len(rocaPrimes))
+		for i, p := range rocaPrimes {
+			generators[i] = make(map[int64]bool)
+			for g := int64(1); !generators[i][g]; g = g * 65537 % p {
+				generators[i][g] = true
+			}
+		}
+		return generators
+	}()
+
+	// smallPrimesProduct is the product of all primes below
+	// smallFactorBound. An RSA modulus sharing a factor with it is trivially
+	// factorable.
+	smallPrimesProduct = func() *big.Int {
+		product := big.NewInt(1)
+		for p := int64(2); p < smallFactorBound; p++ {
+			if big.NewInt(p).ProbablyPrime(0) {
+				product.Mul(product, big.NewInt(p))
+			}
+		}
+		return product
+	}()
+)
+
+// smallFactorBound is the bound below which RSA moduli are checked for prime
+// factors.
+const smallFactorBound = 1 << 16
+
+// weakKeys checks public keys for known weaknesses, including those on the
+// blocklists given with flags.
+type weakKeys struct {
+	// blocklistFiles are the paths of the blocklist files to load.
+	blocklistFiles []string
+
+	// blocklist contains the lowercase hexadecimal fingerprints of the
+	// blocklisted keys.
+	blocklist map[string]bool
+}
+
+// load reads the blocklist files. Each line of a blocklist file is either a
+// SHA-256 fingerprint of the DER encoded SubjectPublicKeyInfo of a key, or a
+// fingerprint in the format of the Debian openssl-blacklist package: the last
+// 80 bits of the SHA-1 of "Modulus=<uppercase hexadecimal modulus>\n". Empty
+// lines and lines starting with "#" are ignored.
+func (w *weakKeys) load() error {
+	blocklist := make(map[string]bool)
+
+	for _, path := range w.blocklistFiles {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open weak key blocklist: %w", err)
+		}
+
+		scanner := bufio.NewScanner(f)
+		for line := 1; scanner.Scan(); line++ {
+			fingerprint := strings.ToLower(strings.TrimSpace(scanner.Text()))
+			if len(fingerprint) == 0 || strings.HasPrefix(fingerprint, "#") {
+				continue
+			}
+			if _, err := hex.DecodeString(fingerprint); err != nil || (len(fingerprint) != 2*sha256.Size && len(fingerprint) != 20) {
+				f.Close()
+				return fmt.Errorf("invalid fingerprint in weak key blocklist %q on line %d", path, line)
+			}
+			blocklist[fingerprint] = true
+		}
+		f.Close()
+
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read weak key blocklist %q: %w", path, err)
+		}
+	}
+
+	w.blocklist = blocklist
+	return nil
+}
+
+// weakness returns the reason why the public key is weak, or an empty string
+// if it has no known weakness.
+func (w *weakKeys) weakness(pub any) (string, error) {
+	if rsaPub, ok := pub.(*rsa.PublicKey); ok {
+		if isROCAVulnerable(rsaPub.N) {
+			return "RSA modulus is vulnerable to ROCA", nil
+		}
+		if hasSmallFactor(rsaPub.N) {
+			return "RSA modulus has a small prime factor", nil
+		}
+	}
+
+	if w == nil || len(w.blocklist) == 0 {
+		return "", nil
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	if w.blocklist[hex.EncodeToString(sum[:])] {
+		return "public key is blocklisted", nil
+	}
+
+	if rsaPub, ok := pub.(*rsa.PublicKey); ok && w.blocklist[debianFingerprint(rsaPub.N)] {
+		return "public key is blocklisted", nil
+	}
+
+	return "", nil
+}
+
+// debianFingerprint returns the fingerprint of an RSA modulus in the format of
+// the Debian openssl-blacklist package.
+func debianFingerprint(n *big.Int) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("Modulus=%X\n", n))) // #nosec G401 -- see import
+	return hex.EncodeToString(sum[:])[20:]
+}
+
+// isROCAVulnerable returns whether the RSA modulus has the fingerprint of
+// moduli generated by the vulnerable Infineon RSALib.
+func isROCAVulnerable(n *big.Int) bool {
+	var r big.Int
+	for i, p := range rocaPrimes {
+		if !rocaGenerators[i][r.Mod(n, big.NewInt(p)).Int64()] {
+			return false
+		}
+	}
+	return true
+}
+
+// hasSmallFactor returns whether the RSA modulus has a prime factor below
+// smallFactorBound.
+func hasSmallFactor(n *big.Int) bool {
+	var gcd big.Int
+	return gcd.GCD(nil, nil, n, smallPrimesProduct).Cmp(big.NewInt(1)) != 0
+}
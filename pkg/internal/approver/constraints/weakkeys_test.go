@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constraints
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_weakness(t *testing.T) {
+	rsaKey, err := utilpki.GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+	ecKey, err := utilpki.GenerateECPrivateKey(utilpki.ECCurve256)
+	require.NoError(t, err)
+	debianKey, err := utilpki.GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+
+	// A modulus with the ROCA fingerprint, which is a power of 65537 modulo
+	// each of the ROCA primes.
+	m := big.NewInt(1)
+	for _, p := range rocaPrimes {
+		m.Mul(m, big.NewInt(p))
+	}
+	rocaN := new(big.Int).Exp(big.NewInt(65537), big.NewInt(1234), m)
+	rocaN.Add(rocaN, new(big.Int).Mul(m, new(big.Int).Lsh(big.NewInt(1), 1800)))
+
+	// A modulus with a small prime factor.
+	smallFactorN := new(big.Int).Mul(big.NewInt(65521), rsaKey.N)
+
+	der, err := x509.MarshalPKIXPublicKey(ecKey.Public())
+	require.NoError(t, err)
+	sum := sha256.Sum256(der)
+
+	blocklist := filepath.Join(t.TempDir(), "blocklist")
+	require.NoError(t, os.WriteFile(blocklist, []byte("# blocklisted keys\n\n"+
+		hex.EncodeToString(sum[:])+"\n"+
+		debianFingerprint(debianKey.N)+"\n",
+	), 0600))
+
+	w := &weakKeys{blocklistFiles: []string{blocklist}}
+	require.NoError(t, w.load())
+
+	tests := map[string]struct {
+		weakKeys    *weakKeys
+		pub         any
+		expWeakness string
+	}{
+		"RSA key without weakness": {
+			weakKeys: w, pub: rsaKey.Public(), expWeakness: "",
+		},
+		"RSA key vulnerable to ROCA": {
+			weakKeys: w, pub: &rsa.PublicKey{N: rocaN, E: 65537}, expWeakness: "RSA modulus is vulnerable to ROCA",
+		},
+		"RSA key with a small factor": {
+			weakKeys: w, pub: &rsa.PublicKey{N: smallFactorN, E: 65537}, expWeakness: "RSA modulus has a small prime factor",
+		},
+		"key blocklisted by SubjectPublicKeyInfo fingerprint": {
+			weakKeys: w, pub: ecKey.Public(), expWeakness: "public key is blocklisted",
+		},
+		"RSA key blocklisted by Debian fingerprint": {
+			weakKeys: w, pub: debianKey.Public(), expWeakness: "public key is blocklisted",
+		},
+		"blocklisted key without loaded blocklist": {
+			weakKeys: nil, pub: ecKey.Public(), expWeakness: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			weakness, err := test.weakKeys.weakness(test.pub)
+			require.NoError(t, err)
+			assert.Equal(t, test.expWeakness, weakness)
+		})
+	}
+}
+
+func Test_load(t *testing.T) {
+	dir := t.TempDir()
+
+	invalid := filepath.Join(dir, "invalid")
+	require.NoError(t, os.WriteFile(invalid, []byte("0000a8c8ba4d4a0bd5ad\nfoo\n"), 0600))
+
+	w := &weakKeys{blocklistFiles: []string{invalid}}
+	assert.EqualError(t, w.load(), `invalid fingerprint in weak key blocklist "`+invalid+`" on line 2`)
+
+	w = &weakKeys{blocklistFiles: []string{filepath.Join(dir, "missing")}}
+	assert.Error(t, w.load())
+}
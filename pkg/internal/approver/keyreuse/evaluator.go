@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyreuse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+func (k *keyReuse) Evaluate(ctx context.Context, policy *policyapi.CertificateRequestPolicy, request *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+	// If no public key reuse constraints defined, exit early.
+	if policy.Spec.Constraints == nil || policy.Spec.Constraints.PublicKeyReuse == nil {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	var (
+		reuse   = policy.Spec.Constraints.PublicKeyReuse
+		fldPath = field.NewPath("spec", "constraints", "publicKeyReuse")
+
+		denyAcrossNamespaces  = ptr.Deref(reuse.DenyAcrossNamespaces, false)
+		certificateName       = request.Annotations[cmapi.CertificateNameKey]
+		denyWithinCertificate = ptr.Deref(reuse.DenyWithinCertificate, false) && len(certificateName) > 0
+	)
+
+	if !denyAcrossNamespaces && !denyWithinCertificate {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	if k.lister == nil {
+		return approver.EvaluationResponse{}, errors.New("keyreuse approver has not been prepared")
+	}
+
+	fingerprint, err := publicKeyFingerprint(request)
+	if err != nil {
+		return approver.EvaluationResponse{}, err
+	}
+
+	// Approvals which have not yet been observed by the cache are not listed,
+	// so public key reuse is checked best-effort.
+	var requests cmapi.CertificateRequestList
+	if err := k.lister.List(ctx, &requests, client.MatchingFields{indexPublicKey: fingerprint}); err != nil {
+		return approver.EvaluationResponse{}, fmt.Errorf("failed to list certificaterequests by public key: %w", err)
+	}
+
+	var el field.ErrorList
+	var usedAcrossNamespaces, usedWithinCertificate bool
+	for _, cr := range requests.Items {
+		if cr.Namespace == request.Namespace && cr.Name == request.Name {
+			continue
+		}
+		if cr.Namespace != request.Namespace {
+			usedAcrossNamespaces = true
+		} else if cr.Annotations[cmapi.CertificateNameKey] == certificateName {
+			usedWithinCertificate = true
+		}
+	}
+
+	// Requests in other namespaces are not named, so that the message does not
+	// disclose them to the requester.
+	if denyAcrossNamespaces && usedAcrossNamespaces {
+		el = append(el, field.Forbidden(fldPath.Child("denyAcrossNamespaces"), "public key is used by a CertificateRequest in another namespace"))
+	}
+	if denyWithinCertificate && usedWithinCertificate {
+		el = append(el, field.Forbidden(fldPath.Child("denyWithinCertificate"), fmt.Sprintf("public key is used by a previous CertificateRequest of Certificate %q", certificateName)))
+	}
+
+	// If there are errors, then return not approved and the aggregated errors
+	if len(el) > 0 {
+		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error()}, nil
+	}
+
+	// If no evaluation errors resulting from this policy, return not denied
+	return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyreuse
+
+import (
+	"context"
+	"crypto"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// builderIndexer registers field indexes with a fake client builder.
+type builderIndexer struct {
+	builder *fakeclient.ClientBuilder
+}
+
+func (b builderIndexer) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, fn)
+	return nil
+}
+
+func Test_Evaluate(t *testing.T) {
+	fldPath := field.NewPath("spec", "constraints", "publicKeyReuse")
+
+	sharedKey, err := utilpki.GenerateECPrivateKey(utilpki.ECCurve256)
+	require.NoError(t, err)
+	otherKey, err := utilpki.GenerateECPrivateKey(utilpki.ECCurve256)
+	require.NoError(t, err)
+
+	csrWithKey := func(sk crypto.Signer) []byte {
+		csr, err := gen.CSRWithSigner(sk, gen.SetCSRCommonName("example.com"))
+		require.NoError(t, err)
+		return csr
+	}
+	approved := gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+		Type: cmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue,
+	})
+	request := func(namespace, name, certificate string, sk crypto.Signer, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		mods = append(mods,
+			gen.SetCertificateRequestNamespace(namespace),
+			gen.SetCertificateRequestCSR(csrWithKey(sk)),
+		)
+		if len(certificate) > 0 {
+			mods = append(mods, gen.AddCertificateRequestAnnotations(map[string]string{cmapi.CertificateNameKey: certificate}))
+		}
+		return gen.CertificateRequest(name, mods...)
+	}
+
+	objects := []client.Object{
+		request("tenant-a", "cert-1-1", "cert-1", sharedKey, approved),
+		request("tenant-b", "cert-2-1", "cert-2", sharedKey),
+		request("tenant-b", "cert-3-1", "cert-3", otherKey, approved),
+	}
+
+	builder := fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(objects...)
+	require.NoError(t, RegisterIndexes(context.TODO(), builderIndexer{builder: builder}))
+	k := &keyReuse{lister: builder.Build()}
+
+	tests := map[string]struct {
+		reuse       *policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse
+		request     *cmapi.CertificateRequest
+		expResponse approver.EvaluationResponse
+	}{
+		"if no public key reuse constraints defined, return NotDenied": {
+			request:     request("tenant-b", "cert-2-2", "cert-2", sharedKey),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if key is used by an approved request in another namespace, return Denied": {
+			reuse:   &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyAcrossNamespaces: ptr.To(true)},
+			request: request("tenant-b", "cert-2-2", "cert-2", sharedKey),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("denyAcrossNamespaces"), "public key is used by a CertificateRequest in another namespace"),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if key is used by an approved request in the same namespace, return NotDenied": {
+			reuse:       &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyAcrossNamespaces: ptr.To(true)},
+			request:     request("tenant-a", "cert-1-2", "cert-1", sharedKey),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if key is used by an approved request of the same Certificate, return Denied": {
+			reuse:   &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyAcrossNamespaces: ptr.To(true), DenyWithinCertificate: ptr.To(true)},
+			request: request("tenant-a", "cert-1-2", "cert-1", sharedKey),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("denyWithinCertificate"), `public key is used by a previous CertificateRequest of Certificate "cert-1"`),
+				}.ToAggregate().Error(),
+			},
+		},
+		"if key is used by an approved request of another Certificate, return NotDenied": {
+			reuse:       &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyWithinCertificate: ptr.To(true)},
+			request:     request("tenant-b", "cert-4-1", "cert-4", otherKey),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if key is used in another namespace only by a request which is not approved, return NotDenied": {
+			reuse:       &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyAcrossNamespaces: ptr.To(true)},
+			request:     request("tenant-a", "cert-5-1", "cert-5", sharedKey),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if the request itself is approved, it is not compared with itself": {
+			reuse:       &policyapi.CertificateRequestPolicyConstraintsPublicKeyReuse{DenyAcrossNamespaces: ptr.To(true), DenyWithinCertificate: ptr.To(true)},
+			request:     request("tenant-b", "cert-3-1", "cert-3", otherKey, approved),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Constraints: &policyapi.CertificateRequestPolicyConstraints{PublicKeyReuse: test.reuse},
+			}}
+			response, err := k.Evaluate(context.TODO(), policy, test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyreuse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/registry"
+)
+
+// indexPublicKey indexes approved CertificateRequests by the SHA-256
+// fingerprint of the SubjectPublicKeyInfo of their CSR.
+const indexPublicKey = "spec.request.publicKey"
+
+// Load the keyreuse approver.
+func init() {
+	registry.Shared.Store(Approver())
+}
+
+// Approver returns an instance of the keyreuse approver.
+func Approver() approver.Interface {
+	return new(keyReuse)
+}
+
+// keyReuse is an approver-policy Approver that is responsible for denying
+// requests which reuse the public key of previously approved requests, as
+// constrained by the `publicKeyReuse` constraints of CertificateRequestPolicies.
+// Approved CertificateRequests are looked up by their public key from the
+// cache of the manager.
+type keyReuse struct {
+	lister client.Reader
+}
+
+// Name of Approver is "keyreuse"
+func (k *keyReuse) Name() string {
+	return "keyreuse"
+}
+
+// RegisterFlags is a no-op, keyreuse doesn't need any flags.
+func (k *keyReuse) RegisterFlags(_ *pflag.FlagSet) {}
+
+// Prepare registers the public key index of CertificateRequests with the
+// cache of the manager, which is used to look up approved requests.
+func (k *keyReuse) Prepare(ctx context.Context, _ logr.Logger, mgr manager.Manager) error {
+	if err := RegisterIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+	k.lister = mgr.GetCache()
+	return nil
+}
+
+// Ready always returns ready, keyreuse doesn't have any dependencies to
+// block readiness.
+func (k *keyReuse) Ready(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
+	return approver.ReconcilerReadyResponse{Ready: true}, nil
+}
+
+// keyreuse never needs to manually enqueue policies.
+func (k *keyReuse) EnqueueChan() <-chan string {
+	return nil
+}
+
+// Validate always allows, the publicKeyReuse constraints have no values
+// which may be invalid.
+func (k *keyReuse) Validate(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.WebhookValidationResponse, error) {
+	return approver.WebhookValidationResponse{Allowed: true}, nil
+}
+
+// RegisterIndexes registers the public key index of CertificateRequests. Must
+// be called with the field indexer of the cache that is used to look up
+// approved requests, before the cache is started.
+func RegisterIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &cmapi.CertificateRequest{}, indexPublicKey, func(obj client.Object) []string {
+		cr := obj.(*cmapi.CertificateRequest)
+		if !apiutil.CertificateRequestIsApproved(cr) {
+			return nil
+		}
+		fingerprint, err := publicKeyFingerprint(cr)
+		if err != nil {
+			return nil
+		}
+		return []string{fingerprint}
+	}); err != nil {
+		return fmt.Errorf("failed to index certificaterequests by %s: %w", indexPublicKey, err)
+	}
+
+	return nil
+}
+
+// publicKeyFingerprint returns the SHA-256 fingerprint of the
+// SubjectPublicKeyInfo of the CSR of the request.
+func publicKeyFingerprint(cr *cmapi.CertificateRequest) (string, error) {
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(csr.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// minRequeueAfter is the shortest duration a limited request is reviewed
+// again after, so that requests are not reviewed in a tight loop when
+// approvals are about to leave the window.
+const minRequeueAfter = time.Second
+
+func (l *limits) Evaluate(ctx context.Context, policy *policyapi.CertificateRequestPolicy, request *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+	// If no limits defined, or the policy denies requests, exit early.
+	if policy.Spec.Limits == nil || policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	if l.lister == nil {
+		return approver.EvaluationResponse{}, errors.New("limits approver has not been prepared")
+	}
+
+	var (
+		lims    = policy.Spec.Limits
+		fldPath = field.NewPath("spec", "limits")
+		now     = l.clock.Now()
+		window  = lims.Window.Duration
+
+		el           field.ErrorList
+		requeueAfter time.Duration
+	)
+
+	// limit records a limit which the request would exceed. The request is
+	// reviewed again once the given approval time leaves the window. A limit
+	// which no approval leaving the window would meet is reviewed again after
+	// the window, in case the policy has changed.
+	limit := func(fldPath *field.Path, msg string, expires *time.Time) {
+		el = append(el, field.Forbidden(fldPath, msg))
+		if expires == nil {
+			requeueAfter = max(requeueAfter, window)
+			return
+		}
+		requeueAfter = max(requeueAfter, expires.Add(window).Sub(now), minRequeueAfter)
+	}
+
+	// approvals returns the approval times within the window of the requests
+	// in the index, in ascending order.
+	approvals := func(index, value string) ([]time.Time, []*cmapi.CertificateRequest, error) {
+		requests, err := l.approvedRequests(ctx, request, now.Add(-window), index, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		var times []time.Time
+		for _, cr := range requests {
+			t, _ := approvalTime(cr)
+			times = append(times, t)
+		}
+		slices.SortFunc(times, time.Time.Compare)
+		return times, requests, nil
+	}
+
+	// countLimit records the limit if the approvals, with the request, would
+	// exceed the maximum.
+	countLimit := func(fldPath *field.Path, maximum int, times []time.Time, msg string) {
+		if len(times) < maximum {
+			return
+		}
+		if maximum == 0 {
+			limit(fldPath, msg, nil)
+			return
+		}
+		// Enough approvals must leave the window for the request to fit.
+		limit(fldPath, msg, &times[len(times)-maximum])
+	}
+
+	if lims.MaxApprovalsPerNamespace != nil || lims.MaxDNSNamesPerNamespace != nil {
+		times, requests, err := approvals(indexNamespace, request.Namespace)
+		if err != nil {
+			return approver.EvaluationResponse{}, err
+		}
+
+		if lims.MaxApprovalsPerNamespace != nil {
+			countLimit(fldPath.Child("maxApprovalsPerNamespace"), *lims.MaxApprovalsPerNamespace, times,
+				fmt.Sprintf("%d requests approved in namespace %q within %s", len(times), request.Namespace, window))
+		}
+
+		if lims.MaxDNSNamesPerNamespace != nil {
+			maximum := *lims.MaxDNSNamesPerNamespace
+			fldPath := fldPath.Child("maxDNSNamesPerNamespace")
+
+			// The latest approval time of each DNS name approved in the window.
+			latest := make(map[string]time.Time)
+			for _, cr := range requests {
+				t, _ := approvalTime(cr)
+				for _, name := range requestDNSNames(cr) {
+					if t.After(latest[name]) {
+						latest[name] = t
+					}
+				}
+			}
+
+			var newNames int
+			for _, name := range requestDNSNames(request) {
+				if _, ok := latest[name]; !ok {
+					newNames++
+				}
+			}
+
+			if newNames > 0 && len(latest)+newNames > maximum {
+				msg := fmt.Sprintf("%d distinct DNS names approved in namespace %q within %s, request adds %d", len(latest), request.Namespace, window, newNames)
+				if newNames > maximum {
+					limit(fldPath, msg, nil)
+				} else {
+					// Names leave the window once their latest approval does.
+					var times []time.Time
+					for _, t := range latest {
+						times = append(times, t)
+					}
+					slices.SortFunc(times, time.Time.Compare)
+					limit(fldPath, msg, &times[len(latest)+newNames-maximum-1])
+				}
+			}
+		}
+	}
+
+	if lims.MaxApprovalsPerRequester != nil {
+		times, _, err := approvals(indexUsername, request.Spec.Username)
+		if err != nil {
+			return approver.EvaluationResponse{}, err
+		}
+		// The requester is not named, so that the message does not disclose
+		// approvals in other namespaces.
+		countLimit(fldPath.Child("maxApprovalsPerRequester"), *lims.MaxApprovalsPerRequester, times,
+			fmt.Sprintf("%d requests approved for the requester within %s", len(times), window))
+	}
+
+	if lims.MaxApprovalsPerDNSName != nil {
+		for _, name := range requestDNSNames(request) {
+			times, _, err := approvals(indexDNSName, name)
+			if err != nil {
+				return approver.EvaluationResponse{}, err
+			}
+			countLimit(fldPath.Child("maxApprovalsPerDNSName"), *lims.MaxApprovalsPerDNSName, times,
+				fmt.Sprintf("%d requests approved for DNS name %q within %s", len(times), name, window))
+		}
+	}
+
+	if len(el) == 0 {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	// Requests which exceed a limit are never denied, but are left
+	// unprocessed to be reviewed again.
+	return approver.EvaluationResponse{
+		Result:       approver.ResultNotDenied,
+		Message:      el.ToAggregate().Error(),
+		RequeueAfter: requeueAfter,
+	}, nil
+}
+
+// approvedRequests returns the requests in the index which were approved by
+// approver-policy after since, excluding the request itself.
+// Approvals which have not yet been observed by the cache are not returned,
+// so limits are best-effort.
+// The index holds every approved request, so the requests are listed without
+// deep copying them and those outside the window are skipped. The returned
+// requests are shared with the cache and must not be modified.
+func (l *limits) approvedRequests(ctx context.Context, request *cmapi.CertificateRequest, since time.Time, index, value string) ([]*cmapi.CertificateRequest, error) {
+	var list cmapi.CertificateRequestList
+	if err := l.lister.List(ctx, &list, client.MatchingFields{index: value}, client.UnsafeDisableDeepCopy); err != nil {
+		return nil, fmt.Errorf("failed to list certificaterequests by %s: %w", index, err)
+	}
+
+	var requests []*cmapi.CertificateRequest
+	for i := range list.Items {
+		cr := &list.Items[i]
+		if cr.Namespace == request.Namespace && cr.Name == request.Name {
+			continue
+		}
+		if t, ok := approvalTime(cr); ok && t.After(since) {
+			requests = append(requests, cr)
+		}
+	}
+	return requests, nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// builderIndexer registers field indexes with a fake client builder.
+type builderIndexer struct {
+	builder *fakeclient.ClientBuilder
+}
+
+func (b builderIndexer) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, fn)
+	return nil
+}
+
+func Test_Evaluate(t *testing.T) {
+	var (
+		fldPath = field.NewPath("spec", "limits")
+		now     = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		window  = metav1.Duration{Duration: time.Hour}
+	)
+
+	sk, err := utilpki.GenerateECPrivateKey(utilpki.ECCurve256)
+	require.NoError(t, err)
+
+	approvedAt := func(ago time.Duration, reason string) gen.CertificateRequestModifier {
+		return gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type: cmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue,
+			Reason: reason, LastTransitionTime: &metav1.Time{Time: now.Add(-ago)},
+		})
+	}
+	request := func(namespace, name, username string, dnsNames []string, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		csr, err := gen.CSRWithSigner(sk, gen.SetCSRDNSNames(dnsNames...))
+		require.NoError(t, err)
+		mods = append(mods,
+			gen.SetCertificateRequestNamespace(namespace),
+			gen.SetCertificateRequestCSR(csr),
+			gen.SetCertificateRequestUsername(username),
+		)
+		return gen.CertificateRequest(name, mods...)
+	}
+
+	objects := []client.Object{
+		request("tenant-a", "cr-1", "alice", []string{"a.example.com"}, approvedAt(50*time.Minute, approvedReason)),
+		request("tenant-a", "cr-2", "alice", []string{"a.example.com", "b.example.com"}, approvedAt(20*time.Minute, approvedReason)),
+		request("tenant-a", "cr-3", "bob", []string{"c.example.com"}, approvedAt(2*time.Hour, approvedReason)),
+		request("tenant-a", "cr-4", "bob", []string{"c.example.com"}, approvedAt(10*time.Minute, "cert-manager.io")),
+		request("tenant-a", "cr-5", "bob", []string{"c.example.com"}),
+		request("tenant-b", "cr-6", "alice", []string{"A.Example.com."}, approvedAt(30*time.Minute, approvedReason)),
+	}
+
+	builder := fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				// Every approved request is indexed, so they must not be deep copied.
+				listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+				assert.True(t, ptr.Deref(listOpts.UnsafeDisableDeepCopy, false), "expected requests to be listed without deep copy")
+				return c.List(ctx, list, opts...)
+			},
+		})
+	require.NoError(t, RegisterIndexes(context.TODO(), builderIndexer{builder: builder}))
+	l := &limits{lister: builder.Build(), clock: fakeclock.NewFakePassiveClock(now)}
+
+	tests := map[string]struct {
+		effect      policyapi.CertificateRequestPolicyEffect
+		limits      *policyapi.CertificateRequestPolicyLimits
+		request     *cmapi.CertificateRequest
+		expResponse approver.EvaluationResponse
+	}{
+		"if no limits defined, return NotDenied": {
+			request:     request("tenant-a", "new", "alice", []string{"a.example.com"}),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if the policy is a Deny policy, return NotDenied": {
+			effect:      policyapi.CertificateRequestPolicyEffectDeny,
+			limits:      &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerNamespace: ptr.To(0)},
+			request:     request("tenant-a", "new", "alice", []string{"a.example.com"}),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if approvals in the namespace are within the limit, return NotDenied": {
+			limits:      &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerNamespace: ptr.To(3)},
+			request:     request("tenant-a", "new", "carol", []string{"d.example.com"}),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if approvals in the namespace reach the limit, return NotDenied and requeue once the oldest approval leaves the window": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerNamespace: ptr.To(2)},
+			request: request("tenant-a", "new", "carol", []string{"d.example.com"}),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxApprovalsPerNamespace"), `2 requests approved in namespace "tenant-a" within 1h0m0s`),
+				}.ToAggregate().Error(),
+				RequeueAfter: 10 * time.Minute,
+			},
+		},
+		"if approvals for the requester reach the limit, return NotDenied and requeue once enough approvals leave the window": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerRequester: ptr.To(2)},
+			request: request("tenant-c", "new", "alice", nil),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxApprovalsPerRequester"), "3 requests approved for the requester within 1h0m0s"),
+				}.ToAggregate().Error(),
+				RequeueAfter: 30 * time.Minute,
+			},
+		},
+		"if approvals for a DNS name reach the limit, return NotDenied": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerDNSName: ptr.To(3)},
+			request: request("tenant-c", "new", "carol", []string{"b.example.com", "a.example.com"}),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxApprovalsPerDNSName"), `3 requests approved for DNS name "a.example.com" within 1h0m0s`),
+				}.ToAggregate().Error(),
+				RequeueAfter: 10 * time.Minute,
+			},
+		},
+		"if requests approved before the window, or not by approver-policy, are ignored, return NotDenied": {
+			limits:      &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerDNSName: ptr.To(1)},
+			request:     request("tenant-a", "new", "bob", []string{"c.example.com"}),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if the request only uses DNS names already approved in the namespace, return NotDenied": {
+			limits:      &policyapi.CertificateRequestPolicyLimits{Window: window, MaxDNSNamesPerNamespace: ptr.To(2)},
+			request:     request("tenant-a", "new", "alice", []string{"b.example.com"}),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if the request adds DNS names beyond the limit in the namespace, return NotDenied and requeue once enough names leave the window": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxDNSNamesPerNamespace: ptr.To(2)},
+			request: request("tenant-a", "new", "alice", []string{"d.example.com"}),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxDNSNamesPerNamespace"), `2 distinct DNS names approved in namespace "tenant-a" within 1h0m0s, request adds 1`),
+				}.ToAggregate().Error(),
+				RequeueAfter: 40 * time.Minute,
+			},
+		},
+		"if the request adds more DNS names than the limit, return NotDenied and requeue after the window": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxDNSNamesPerNamespace: ptr.To(1)},
+			request: request("tenant-c", "new", "alice", []string{"d.example.com", "e.example.com"}),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxDNSNamesPerNamespace"), `0 distinct DNS names approved in namespace "tenant-c" within 1h0m0s, request adds 2`),
+				}.ToAggregate().Error(),
+				RequeueAfter: time.Hour,
+			},
+		},
+		"if a limit is 0, return NotDenied and requeue after the window": {
+			limits:  &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerNamespace: ptr.To(0)},
+			request: request("tenant-c", "new", "alice", nil),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultNotDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("maxApprovalsPerNamespace"), `0 requests approved in namespace "tenant-c" within 1h0m0s`),
+				}.ToAggregate().Error(),
+				RequeueAfter: time.Hour,
+			},
+		},
+		"if the request itself is approved, it is not counted": {
+			limits:      &policyapi.CertificateRequestPolicyLimits{Window: window, MaxApprovalsPerNamespace: ptr.To(1)},
+			request:     request("tenant-b", "cr-6", "alice", []string{"a.example.com"}, approvedAt(30*time.Minute, approvedReason)),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Effect: test.effect,
+				Limits: test.limits,
+			}}
+			response, err := l.Evaluate(context.TODO(), policy, test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
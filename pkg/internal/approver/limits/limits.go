@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+	"github.com/cert-manager/approver-policy/pkg/registry"
+)
+
+const (
+	// indexNamespace, indexUsername and indexDNSName index the
+	// CertificateRequests approved by approver-policy by their namespace,
+	// requester and DNS names.
+	indexNamespace = "limits.metadata.namespace"
+	indexUsername  = "limits.spec.username"
+	indexDNSName   = "limits.spec.request.dnsNames"
+
+	// approvedReason is the reason of the Approved condition of
+	// CertificateRequests approved by approver-policy.
+	approvedReason = "policy.cert-manager.io"
+)
+
+// Load the limits approver.
+func init() {
+	registry.Shared.Store(Approver())
+}
+
+// Approver returns an instance of the limits approver.
+func Approver() approver.Interface {
+	return &limits{clock: clock.RealClock{}}
+}
+
+// limits is an approver-policy Approver that is responsible for delaying
+// requests whose approval would exceed the limits of CertificateRequestPolicies.
+// Approvals are counted from the CertificateRequests in the cache of the
+// manager.
+type limits struct {
+	lister client.Reader
+	clock  clock.PassiveClock
+}
+
+// Name of Approver is "limits"
+func (l *limits) Name() string {
+	return "limits"
+}
+
+// RegisterFlags is a no-op, limits doesn't need any flags.
+func (l *limits) RegisterFlags(_ *pflag.FlagSet) {}
+
+// Prepare registers the indexes of approved CertificateRequests with the cache
+// of the manager, which are used to count approvals.
+func (l *limits) Prepare(ctx context.Context, _ logr.Logger, mgr manager.Manager) error {
+	if err := RegisterIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+	l.lister = mgr.GetCache()
+	return nil
+}
+
+// Ready always returns ready, limits doesn't have any dependencies to block
+// readiness.
+func (l *limits) Ready(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
+	return approver.ReconcilerReadyResponse{Ready: true}, nil
+}
+
+// limits never needs to manually enqueue policies.
+func (l *limits) EnqueueChan() <-chan string {
+	return nil
+}
+
+// RegisterIndexes registers the indexes of approved CertificateRequests. Must
+// be called with the field indexer of the cache that is used to count
+// approvals, before the cache is started.
+func RegisterIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	indexes := map[string]func(*cmapi.CertificateRequest) []string{
+		indexNamespace: func(cr *cmapi.CertificateRequest) []string {
+			return []string{cr.Namespace}
+		},
+		indexUsername: func(cr *cmapi.CertificateRequest) []string {
+			return []string{cr.Spec.Username}
+		},
+		indexDNSName: requestDNSNames,
+	}
+
+	for field, values := range indexes {
+		if err := indexer.IndexField(ctx, &cmapi.CertificateRequest{}, field, func(obj client.Object) []string {
+			cr := obj.(*cmapi.CertificateRequest)
+			if _, ok := approvalTime(cr); !ok {
+				return nil
+			}
+			return values(cr)
+		}); err != nil {
+			return fmt.Errorf("failed to index certificaterequests by %s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// approvalTime returns the time the request was approved by approver-policy,
+// or false if it has not been.
+func approvalTime(cr *cmapi.CertificateRequest) (time.Time, bool) {
+	for _, condition := range cr.Status.Conditions {
+		if condition.Type == cmapi.CertificateRequestConditionApproved &&
+			condition.Status == cmmeta.ConditionTrue &&
+			condition.Reason == approvedReason &&
+			condition.LastTransitionTime != nil {
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// requestDNSNames returns the normalised and de-duplicated DNS names of the
+// CSR of the request.
+func requestDNSNames(cr *cmapi.CertificateRequest) []string {
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range csr.DNSNames {
+		name = util.NormalizeDNSNameKey(name)
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// Validate validates that the processed CertificateRequestPolicy has valid
+// limits defined.
+func (l *limits) Validate(_ context.Context, policy *policyapi.CertificateRequestPolicy) (approver.WebhookValidationResponse, error) {
+	// If no limits are defined we can exit early
+	if policy.Spec.Limits == nil {
+		return approver.WebhookValidationResponse{Allowed: true}, nil
+	}
+
+	var (
+		el      field.ErrorList
+		lims    = policy.Spec.Limits
+		fldPath = field.NewPath("spec", "limits")
+	)
+
+	if policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny {
+		el = append(el, field.Forbidden(fldPath, "limits cannot be defined on Deny policies"))
+	}
+
+	if lims.Window.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("window"), lims.Window.Duration.String(), "must be greater than 0"))
+	}
+
+	for _, maximum := range []struct {
+		name  string
+		value *int
+	}{
+		{"maxApprovalsPerNamespace", lims.MaxApprovalsPerNamespace},
+		{"maxApprovalsPerRequester", lims.MaxApprovalsPerRequester},
+		{"maxApprovalsPerDNSName", lims.MaxApprovalsPerDNSName},
+		{"maxDNSNamesPerNamespace", lims.MaxDNSNamesPerNamespace},
+	} {
+		if maximum.value != nil && *maximum.value < 0 {
+			el = append(el, field.Invalid(fldPath.Child(maximum.name), *maximum.value, "must be 0 or larger"))
+		}
+	}
+
+	return approver.WebhookValidationResponse{
+		Allowed: len(el) == 0,
+		Errors:  el,
+	}, nil
+}
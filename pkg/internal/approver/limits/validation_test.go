@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+func Test_Validate(t *testing.T) {
+	fldPath := field.NewPath("spec", "limits")
+
+	tests := map[string]struct {
+		effect      policyapi.CertificateRequestPolicyEffect
+		limits      *policyapi.CertificateRequestPolicyLimits
+		expResponse approver.WebhookValidationResponse
+	}{
+		"if no limits defined, return allowed": {
+			expResponse: approver.WebhookValidationResponse{Allowed: true},
+		},
+		"if valid limits defined, return allowed": {
+			limits: &policyapi.CertificateRequestPolicyLimits{
+				Window:                   metav1.Duration{Duration: time.Hour},
+				MaxApprovalsPerNamespace: ptr.To(10),
+				MaxApprovalsPerRequester: ptr.To(5),
+				MaxApprovalsPerDNSName:   ptr.To(0),
+				MaxDNSNamesPerNamespace:  ptr.To(100),
+			},
+			expResponse: approver.WebhookValidationResponse{Allowed: true},
+		},
+		"if limits defined on a Deny policy, return not allowed": {
+			effect: policyapi.CertificateRequestPolicyEffectDeny,
+			limits: &policyapi.CertificateRequestPolicyLimits{Window: metav1.Duration{Duration: time.Hour}},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Forbidden(fldPath, "limits cannot be defined on Deny policies"),
+				},
+			},
+		},
+		"if window is not positive and maxima are negative, return not allowed": {
+			limits: &policyapi.CertificateRequestPolicyLimits{
+				MaxApprovalsPerRequester: ptr.To(-1),
+				MaxDNSNamesPerNamespace:  ptr.To(-2),
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(fldPath.Child("window"), "0s", "must be greater than 0"),
+					field.Invalid(fldPath.Child("maxApprovalsPerRequester"), -1, "must be 0 or larger"),
+					field.Invalid(fldPath.Child("maxDNSNamesPerNamespace"), -2, "must be 0 or larger"),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Effect: test.effect,
+				Limits: test.limits,
+			}}
+			response, err := new(limits).Validate(context.TODO(), policy)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+)
+
+const (
+	// indexIssuerRefName, indexIssuerRefKind and indexIssuerRefGroup index
+	// policies by the values of their issuerRef selector.
+	indexIssuerRefName  = "spec.selector.issuerRef.name"
+	indexIssuerRefKind  = "spec.selector.issuerRef.kind"
+	indexIssuerRefGroup = "spec.selector.issuerRef.group"
+
+	// indexNamespaceMatchNames indexes policies by the matchNames of their
+	// namespace selector.
+	indexNamespaceMatchNames = "spec.selector.namespace.matchNames"
+
+	// indexWildcard is the index value of policies which may match any value
+	// of an indexed field, because the field is omitted or contains a
+	// wildcard.
+	indexWildcard = "*"
+)
+
+// RegisterIndexes registers the field indexes used by the approver Manager
+// to look up candidate policies for a CertificateRequest, on both
+// CertificateRequestPolicies and NamespacedCertificateRequestPolicies. Must be
+// called with the field indexer of the cache that is given as the lister to
+// New, before the cache is started.
+func RegisterIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	indexes := map[string]func(*policyapi.CertificateRequestPolicySelector) []string{
+		indexIssuerRefName: func(sel *policyapi.CertificateRequestPolicySelector) []string {
+			if sel.IssuerRef == nil {
+				return []string{indexWildcard}
+			}
+			return indexDNSValues(sel.IssuerRef.Name)
+		},
+		indexIssuerRefKind: func(sel *policyapi.CertificateRequestPolicySelector) []string {
+			if sel.IssuerRef == nil {
+				return []string{indexWildcard}
+			}
+			return indexValues(sel.IssuerRef.Kind)
+		},
+		indexIssuerRefGroup: func(sel *policyapi.CertificateRequestPolicySelector) []string {
+			if sel.IssuerRef == nil {
+				return []string{indexWildcard}
+			}
+			return indexDNSValues(sel.IssuerRef.Group)
+		},
+		indexNamespaceMatchNames: func(sel *policyapi.CertificateRequestPolicySelector) []string {
+			if sel.Namespace == nil || len(sel.Namespace.MatchNames) == 0 {
+				return []string{indexWildcard}
+			}
+			values := sets.New[string]()
+			for _, matchName := range sel.Namespace.MatchNames {
+				values.Insert(indexValues(&matchName)...)
+			}
+			return sets.List(values)
+		},
+	}
+
+	for field, values := range indexes {
+		if err := indexer.IndexField(ctx, &policyapi.CertificateRequestPolicy{}, field, func(obj client.Object) []string {
+			return values(&obj.(*policyapi.CertificateRequestPolicy).Spec.Selector)
+		}); err != nil {
+			return fmt.Errorf("failed to index certificaterequestpolicies by %s: %w", field, err)
+		}
+
+		if err := indexer.IndexField(ctx, &policyapi.NamespacedCertificateRequestPolicy{}, field, func(obj client.Object) []string {
+			return values(&obj.(*policyapi.NamespacedCertificateRequestPolicy).Spec.Selector)
+		}); err != nil {
+			return fmt.Errorf("failed to index namespacedcertificaterequestpolicies by %s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// indexValues returns the index values of an optional selector value.
+// Omitted values, and values containing a wildcard, are indexed under the
+// wildcard value.
+func indexValues(value *string) []string {
+	if value == nil || strings.Contains(*value, "*") {
+		return []string{indexWildcard}
+	}
+	return []string{*value}
+}
+
+// indexDNSValues returns the index values of an optional selector value which
+// may be matched as a DNS name. Values are normalised, so that a request may
+// look up the policies whose selector matches it in either match mode.
+func indexDNSValues(value *string) []string {
+	if value == nil {
+		return indexValues(nil)
+	}
+	return indexValues(ptr.To(util.NormalizeDNSNameKey(*value)))
+}
+
+// requestIndexValues returns the values of the request which are used to
+// query the indexes of policies. Kind and Group are defaulted in the same way
+// as the SelectorIssuerRef predicate.
+func requestIndexValues(cr *cmapi.CertificateRequest) map[string]string {
+	kind := cr.Spec.IssuerRef.Kind
+	if len(kind) == 0 {
+		kind = cmapi.IssuerKind
+	}
+	group := cr.Spec.IssuerRef.Group
+	if len(group) == 0 {
+		group = "cert-manager.io"
+	}
+
+	return map[string]string{
+		indexIssuerRefName:       util.NormalizeDNSNameKey(cr.Spec.IssuerRef.Name),
+		indexIssuerRefKind:       kind,
+		indexIssuerRefGroup:      util.NormalizeDNSNameKey(group),
+		indexNamespaceMatchNames: cr.Namespace,
+	}
+}
+
+// candidateNames returns the names of the objects in the list returned by
+// newList which may match the given index values. An object may match an
+// index value if it is indexed by either that value or the wildcard.
+// Candidates must be matched on every index.
+func candidateNames(ctx context.Context, lister client.Reader, newList func() client.ObjectList, values map[string]string, opts ...client.ListOption) (sets.Set[string], error) {
+	var names sets.Set[string]
+
+	for field, value := range values {
+		fieldNames := sets.New[string]()
+
+		for _, v := range []string{value, indexWildcard} {
+			list := newList()
+			listOpts := append([]client.ListOption{client.MatchingFields{field: v}, client.UnsafeDisableDeepCopy}, opts...)
+			if err := lister.List(ctx, list, listOpts...); err != nil {
+				return nil, err
+			}
+
+			if err := meta.EachListItem(list, func(obj runtime.Object) error {
+				fieldNames.Insert(obj.(client.Object).GetName())
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if names == nil {
+			names = fieldNames
+		} else {
+			names = names.Intersection(fieldNames)
+		}
+
+		if names.Len() == 0 {
+			break
+		}
+	}
+
+	return names, nil
+}
+
+// listCandidatePolicies returns the CertificateRequestPolicies, and the
+// NamespacedCertificateRequestPolicies in the namespace of the request, which
+// may match the request according to the field indexes. Candidate policies
+// must still be filtered by the predicates.
+func (m *mngr) listCandidatePolicies(ctx context.Context, cr *cmapi.CertificateRequest) ([]policyapi.CertificateRequestPolicy, []policyapi.NamespacedCertificateRequestPolicy, error) {
+	values := requestIndexValues(cr)
+
+	namespacedNames, err := candidateNames(ctx, m.lister, func() client.ObjectList { return new(policyapi.NamespacedCertificateRequestPolicyList) },
+		values, client.InNamespace(cr.Namespace))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names, err := candidateNames(ctx, m.lister, func() client.ObjectList { return new(policyapi.CertificateRequestPolicyList) }, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policies []policyapi.CertificateRequestPolicy
+	for _, name := range sets.List(names) {
+		var policy policyapi.CertificateRequestPolicy
+		if err := m.lister.Get(ctx, client.ObjectKey{Name: name}, &policy); err != nil {
+			// The policy may have been deleted since it was listed.
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	var namespacedPolicies []policyapi.NamespacedCertificateRequestPolicy
+	for _, name := range sets.List(namespacedNames) {
+		var policy policyapi.NamespacedCertificateRequestPolicy
+		if err := m.lister.Get(ctx, client.ObjectKey{Namespace: cr.Namespace, Name: name}, &policy); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, nil, err
+		}
+		namespacedPolicies = append(namespacedPolicies, policy)
+	}
+
+	return policies, namespacedPolicies, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+// builderIndexer registers field indexes with a fake client builder.
+type builderIndexer struct {
+	builder *fakeclient.ClientBuilder
+}
+
+func (b builderIndexer) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, fn)
+	return nil
+}
+
+func Test_listPolicies(t *testing.T) {
+	policy := func(name string, selector policyapi.CertificateRequestPolicySelector) client.Object {
+		return &policyapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       policyapi.CertificateRequestPolicySpec{Selector: selector},
+		}
+	}
+	namespacedPolicy := func(namespace, name string, selector policyapi.CertificateRequestPolicySelector) client.Object {
+		return &policyapi.NamespacedCertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       policyapi.CertificateRequestPolicySpec{Selector: selector},
+		}
+	}
+	issuerRef := func(name, kind, group *string) policyapi.CertificateRequestPolicySelector {
+		return policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+			Name: name, Kind: kind, Group: group,
+		}}
+	}
+
+	objects := []client.Object{
+		policy("no-selector", policyapi.CertificateRequestPolicySelector{}),
+		policy("empty-issuer-ref", issuerRef(nil, nil, nil)),
+		policy("matching-issuer-ref", issuerRef(ptr.To("my-issuer"), ptr.To("Issuer"), ptr.To("cert-manager.io"))),
+		policy("wildcard-issuer-ref", issuerRef(ptr.To("my-*"), ptr.To("*"), ptr.To("*.io"))),
+		policy("other-issuer-name", issuerRef(ptr.To("other-issuer"), nil, nil)),
+		policy("other-issuer-kind", issuerRef(nil, ptr.To("ClusterIssuer"), nil)),
+		policy("other-issuer-group", issuerRef(nil, nil, ptr.To("example.com"))),
+		policy("dns-issuer-ref", policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+			Name: ptr.To("My-Issuer."), Group: ptr.To("Cert-Manager.io"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+		}}),
+		policy("matching-namespace", policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+			MatchNames: []string{"other-namespace", "test-namespace"},
+		}}),
+		policy("wildcard-namespace", policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+			MatchNames: []string{"test-*"},
+		}}),
+		policy("label-namespace", policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+			MatchLabels: map[string]string{"foo": "bar"},
+		}}),
+		policy("other-namespace", policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+			MatchNames: []string{"other-namespace"},
+		}}),
+		namespacedPolicy("test-namespace", "namespaced-no-selector", policyapi.CertificateRequestPolicySelector{}),
+		namespacedPolicy("test-namespace", "namespaced-other-issuer-name", issuerRef(ptr.To("other-issuer"), nil, nil)),
+		namespacedPolicy("other-namespace", "namespaced-other-namespace", policyapi.CertificateRequestPolicySelector{}),
+	}
+
+	builder := fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(objects...)
+	require.NoError(t, RegisterIndexes(context.TODO(), builderIndexer{builder: builder}))
+
+	m := &mngr{lister: builder.Build(), indexed: true}
+
+	request := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace"},
+		Spec: cmapi.CertificateRequestSpec{
+			IssuerRef: cmmeta.ObjectReference{Name: "my-issuer"},
+		},
+	}
+
+	policies, namespacedPolicies, err := m.listPolicies(context.TODO(), request)
+	require.NoError(t, err)
+
+	var names, namespacedNames []string
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+	}
+	for _, policy := range namespacedPolicies {
+		namespacedNames = append(namespacedNames, policy.Name)
+	}
+
+	assert.Equal(t, []string{
+		"dns-issuer-ref",
+		"empty-issuer-ref",
+		"label-namespace",
+		"matching-issuer-ref",
+		"matching-namespace",
+		"no-selector",
+		"wildcard-issuer-ref",
+		"wildcard-namespace",
+	}, names)
+	assert.Equal(t, []string{"namespaced-no-selector"}, namespacedNames)
+
+	// Without indexes, every policy, and every namespaced policy in the
+	// namespace of the request, is listed.
+	m = &mngr{lister: fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(objects...).Build()}
+
+	policies, namespacedPolicies, err = m.listPolicies(context.TODO(), request)
+	require.NoError(t, err)
+	assert.Len(t, policies, 12)
+	assert.Len(t, namespacedPolicies, 2)
+}
@@ -19,15 +19,21 @@ package predicate
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
 	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
@@ -53,10 +59,30 @@ func Ready(_ context.Context, _ *cmapi.CertificateRequest, policies []policyapi.
 	return readyPolicies, nil
 }
 
+// Active is a Predicate that returns the subset of given policies that are
+// active at the current time, according to their validity period and
+// schedule. Policies whose schedule cannot be evaluated are never active.
+func Active(clock clock.PassiveClock) Predicate {
+	return func(_ context.Context, _ *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+		var activePolicies []policyapi.CertificateRequestPolicy
+
+		now := clock.Now()
+		for _, policy := range policies {
+			activity, err := util.PolicyActivityAt(&policy.Spec, now)
+			if err == nil && activity.Active {
+				activePolicies = append(activePolicies, policy)
+			}
+		}
+
+		return activePolicies, nil
+	}
+}
+
 // SelectorIssuerRef is a Predicate that returns the subset of given policies
 // that have an `spec.selector.issuerRef` matching the `spec.issuerRef` in the
 // request. PredicateSelectorIssuerRef will match on strings using wilcards
-// "*". Empty selector is equivalent to "*" and will match on anything.
+// "*". Empty selector is equivalent to "*" and will match on anything. The
+// name and group are matched as DNS names if the selector matchMode is DNS.
 func SelectorIssuerRef(_ context.Context, cr *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
 	var matchingPolicies []policyapi.CertificateRequestPolicy
 
@@ -78,13 +104,27 @@ func SelectorIssuerRef(_ context.Context, cr *cmapi.CertificateRequest, policies
 			continue
 		}
 
-		if issRefSel.Name != nil && !util.WildcardMatches(*issRefSel.Name, issName) {
+		matches := util.WildcardMatches
+		if issRefSel.MatchMode == policyapi.CertificateRequestPolicyMatchModeDNS {
+			deny := policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny
+			matches = func(pattern, name string) bool {
+				// Names which cannot be normalised are selected by Deny policies,
+				// so that they can't be used to avoid them.
+				ok, err := util.DNSMatches(pattern, name)
+				if err != nil {
+					return deny
+				}
+				return ok
+			}
+		}
+
+		if issRefSel.Name != nil && !matches(*issRefSel.Name, issName) {
 			continue
 		}
 		if issRefSel.Kind != nil && !util.WildcardMatches(*issRefSel.Kind, issKind) {
 			continue
 		}
-		if issRefSel.Group != nil && !util.WildcardMatches(*issRefSel.Group, issGroup) {
+		if issRefSel.Group != nil && !matches(*issRefSel.Group, issGroup) {
 			continue
 		}
 		matchingPolicies = append(matchingPolicies, policy)
@@ -93,11 +133,47 @@ func SelectorIssuerRef(_ context.Context, cr *cmapi.CertificateRequest, policies
 	return matchingPolicies, nil
 }
 
+// SelectorRequestMetadata is a Predicate that returns the subset of given
+// policies that have an `spec.selector.requestLabels` and
+// `spec.selector.requestAnnotations` matching the labels and annotations of
+// the request. Omitted selectors match on any request.
+func SelectorRequestMetadata(_ context.Context, cr *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+	var matchingPolicies []policyapi.CertificateRequestPolicy
+
+	for _, policy := range policies {
+		if sel := policy.Spec.Selector.RequestLabels; sel != nil {
+			selector, err := metav1.LabelSelectorAsSelector(sel)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse request label selector: %w", err)
+			}
+			if !selector.Matches(labels.Set(cr.Labels)) {
+				continue
+			}
+		}
+
+		if sel := policy.Spec.Selector.RequestAnnotations; sel != nil {
+			selector, err := metav1.LabelSelectorAsSelector(sel)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse request annotation selector: %w", err)
+			}
+			if !selector.Matches(labels.Set(cr.Annotations)) {
+				continue
+			}
+		}
+
+		matchingPolicies = append(matchingPolicies, policy)
+	}
+
+	return matchingPolicies, nil
+}
+
 // SelectorNamespace is a Predicate that returns the subset of given policies
 // that have an `spec.selector.namespace` matching the `metadata.namespace` of
-// the request. SelectorNamespace will match with `namespace.matchNames` on
-// namespaces using wilcards "*". Empty selector is equivalent to "*" and will
-// match on any Namespace.
+// the request. SelectorNamespace will match with `namespace.matchNames` and
+// `namespace.excludeNames` on namespaces using wilcards "*", and with
+// `namespace.matchLabels` and `namespace.matchExpressions` on the labels of
+// the namespace. Empty selector is equivalent to "*" and will match on any
+// Namespace.
 func SelectorNamespace(lister client.Reader) Predicate {
 	return func(ctx context.Context, request *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
 		var matchingPolicies []policyapi.CertificateRequestPolicy
@@ -138,8 +214,15 @@ func SelectorNamespace(lister client.Reader) Predicate {
 				continue
 			}
 
+			// Exclude by name.
+			if slices.ContainsFunc(nsSel.ExcludeNames, func(excludeName string) bool {
+				return util.WildcardMatches(excludeName, request.Namespace)
+			}) {
+				continue
+			}
+
 			// Match by Label Selector.
-			if nsSel.MatchLabels != nil {
+			if nsSel.MatchLabels != nil || len(nsSel.MatchExpressions) > 0 {
 
 				if namespaceLabels == nil {
 					var namespace corev1.Namespace
@@ -150,7 +233,8 @@ func SelectorNamespace(lister client.Reader) Predicate {
 				}
 
 				selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
-					MatchLabels: nsSel.MatchLabels,
+					MatchLabels:      nsSel.MatchLabels,
+					MatchExpressions: nsSel.MatchExpressions,
 				})
 				if err != nil {
 					return nil, fmt.Errorf("failed to parse namespace label selector: %w", err)
@@ -168,18 +252,163 @@ func SelectorNamespace(lister client.Reader) Predicate {
 	}
 }
 
+// SelectorExpression is a Predicate that returns the subset of given policies
+// that have an `spec.selector.expression` which evaluates to true for the
+// request. Policies without an expression always match. An expression which
+// fails to compile or evaluate returns an error, so that the request is
+// reviewed again rather than skipping the policy, which would let requests
+// through a Deny policy.
+func SelectorExpression(lister client.Reader, validators validation.Cache) Predicate {
+	return func(ctx context.Context, request *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+		var matchingPolicies []policyapi.CertificateRequestPolicy
+
+		// namespaceLabels are the labels of the namespace the request is in. We
+		// use a pointer here so we can lazily fetch the namespace as necessary.
+		var namespaceLabels *map[string]string
+
+		// cr is the CEL representation of the request, which is lazily built
+		// once for all expressions.
+		var cr *validation.CertificateRequest
+
+		for _, policy := range policies {
+			expr := policy.Spec.Selector.Expression
+
+			// Expression is nil so we always match.
+			if expr == nil {
+				matchingPolicies = append(matchingPolicies, policy)
+				continue
+			}
+
+			if namespaceLabels == nil {
+				var namespace corev1.Namespace
+				if err := lister.Get(ctx, client.ObjectKey{Name: request.Namespace}, &namespace); err != nil {
+					return nil, fmt.Errorf("failed to get request's namespace to determine selector expression: %w", err)
+				}
+				namespaceLabels = &namespace.Labels
+			}
+
+			if cr == nil {
+				var err error
+				if cr, err = validation.NewCertificateRequest(request); err != nil {
+					return nil, fmt.Errorf("failed to build request to evaluate selector expression: %w", err)
+				}
+			}
+
+			validator, err := validators.GetSelector(*expr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile selector expression of policy %q: %w", policy.Name, err)
+			}
+
+			matched, err := validator.Validate(cr, *namespaceLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate selector expression of policy %q: %w", policy.Name, err)
+			}
+			if !matched {
+				continue
+			}
+
+			matchingPolicies = append(matchingPolicies, policy)
+		}
+
+		return matchingPolicies, nil
+	}
+}
+
+// SelectorRequester is a Predicate that returns the subset of given policies
+// that have an `spec.selector.requester` matching the user in the request.
+// Usernames, groups and service accounts are matched using wildcards "*".
+// Policies without a requester selector always match.
+func SelectorRequester(_ context.Context, cr *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+	var matchingPolicies []policyapi.CertificateRequestPolicy
+
+	saNamespace, saName, saErr := serviceaccount.SplitUsername(cr.Spec.Username)
+	isServiceAccount := saErr == nil
+
+	for _, policy := range policies {
+		reqSel := policy.Spec.Selector.Requester
+
+		// If the requester selector is nil, or doesn't define any requesters,
+		// we match the policy and continue early. Such policies must be bound
+		// with RBAC, see RBACBound.
+		if !selectsRequesters(reqSel) {
+			matchingPolicies = append(matchingPolicies, policy)
+			continue
+		}
+
+		matched := util.WildcardContains(reqSel.Usernames, cr.Spec.Username) ||
+			slices.ContainsFunc(cr.Spec.Groups, func(group string) bool {
+				return util.WildcardContains(reqSel.Groups, group)
+			}) ||
+			(isServiceAccount && slices.ContainsFunc(reqSel.ServiceAccounts, func(sa policyapi.CertificateRequestPolicySelectorServiceAccount) bool {
+				return util.WildcardMatches(sa.Namespace, saNamespace) && util.WildcardMatches(sa.Name, saName)
+			}))
+
+		if matched {
+			matchingPolicies = append(matchingPolicies, policy)
+		}
+	}
+
+	return matchingPolicies, nil
+}
+
 // RBACBoundPolicies is a Predicate that returns the subset of
 // CertificateRequestPolicies that have been RBAC bound to the user in the
-// CertificateRequest. Achieved using SubjectAccessReviews.
-func RBACBound(client client.Client) Predicate {
+// CertificateRequest. Depending on the mode, this is achieved by evaluating
+// the RBAC resources in the lister, using SubjectAccessReviews, or evaluating
+// RBAC and falling back to SubjectAccessReviews for policies which aren't
+// bound by RBAC.
+// Deny CertificateRequestPolicies and NamespacedCertificateRequestPolicies
+// apply to all users, and so are always returned without performing a
+// SubjectAccessReview. Policies which select the requester with
+// `spec.selector.requester` are also returned without performing a
+// SubjectAccessReview, unless `requireRBACBinding` is true. A requester
+// selector which names no usernames, groups or service accounts selects no
+// requesters, and so doesn't replace the RBAC binding.
+func RBACBound(client client.Client, lister client.Reader, mode RBACAuthorizationMode) Predicate {
+	authorizer := rbacAuthorizer{lister: lister}
+
 	return func(ctx context.Context, cr *cmapi.CertificateRequest, policies []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
 		extra := make(map[string]authzv1.ExtraValue)
 		for k, v := range cr.Spec.Extra {
 			extra[k] = v
 		}
 
+		// rules are the RBAC rules bound to the user, which are resolved at
+		// most once per request, and only if a policy needs to be checked.
+		var rules *[]rbacv1.PolicyRule
+
 		var boundPolicies []policyapi.CertificateRequestPolicy
 		for _, policy := range policies {
+			// Deny and namespaced policies are not bound to users.
+			if policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny || util.IsNamespacedPolicy(&policy) {
+				boundPolicies = append(boundPolicies, policy)
+				continue
+			}
+
+			// Policies which select the requester have already been matched to
+			// the user by SelectorRequester.
+			if reqSel := policy.Spec.Selector.Requester; selectsRequesters(reqSel) && !ptr.Deref(reqSel.RequireRBACBinding, false) {
+				boundPolicies = append(boundPolicies, policy)
+				continue
+			}
+
+			if mode != RBACAuthorizationModeSubjectAccessReview {
+				if rules == nil {
+					userRules, err := authorizer.userRules(ctx, cr)
+					if err != nil {
+						return nil, err
+					}
+					rules = &userRules
+				}
+				if rulesAllow(*rules, policy.Name) {
+					boundPolicies = append(boundPolicies, policy)
+					continue
+				}
+				if mode == RBACAuthorizationModeInProcess {
+					continue
+				}
+			}
+
 			// Perform subject access review for this CertificateRequestPolicy
 			rev := &authzv1.SubjectAccessReview{
 				Spec: authzv1.SubjectAccessReviewSpec{
@@ -211,6 +440,12 @@ func RBACBound(client client.Client) Predicate {
 	}
 }
 
+// selectsRequesters returns true if the requester selector names at least one
+// username, group or service account.
+func selectsRequesters(sel *policyapi.CertificateRequestPolicySelectorRequester) bool {
+	return sel != nil && (len(sel.Usernames) > 0 || len(sel.Groups) > 0 || len(sel.ServiceAccounts) > 0)
+}
+
 func nonEmptyOrDefault(s, d string) string {
 	if len(s) == 0 {
 		return d
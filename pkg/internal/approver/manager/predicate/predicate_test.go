@@ -20,6 +20,7 @@ import (
 	"context"
 	"path"
 	"testing"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -29,11 +30,13 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
 	testenv "github.com/cert-manager/approver-policy/test/env"
 )
 
@@ -109,6 +112,77 @@ func Test_RBACBound(t *testing.T) {
 			},
 			expPolicies: nil,
 		},
+		"if Deny CertificateRequestPolicy exists but not bound, return policy": {
+			apiObjects: []client.Object{},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Effect:   policyapi.CertificateRequestPolicyEffectDeny,
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+				},
+			}},
+			expPolicies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Effect:   policyapi.CertificateRequestPolicyEffectDeny,
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+				},
+			}},
+		},
+		"if NamespacedCertificateRequestPolicy exists but not bound, return policy": {
+			apiObjects: []client.Object{},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: requestNamespace, Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+				}},
+			}},
+			expPolicies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: requestNamespace, Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+				}},
+			}},
+		},
+		"if CertificateRequestPolicy selects requester but not bound, return policy": {
+			apiObjects: []client.Object{},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					Requester: &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"*"}},
+				}},
+			}},
+			expPolicies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					Requester: &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"*"}},
+				}},
+			}},
+		},
+		"if CertificateRequestPolicy has a requester selector which names no requesters and not bound, return no policies": {
+			apiObjects: []client.Object{},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					Requester: &policyapi.CertificateRequestPolicySelectorRequester{RequireRBACBinding: ptr.To(false)},
+				}},
+			}},
+			expPolicies: nil,
+		},
+		"if CertificateRequestPolicy selects requester and requires RBAC binding but not bound, return no policies": {
+			apiObjects: []client.Object{},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec: policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{
+					IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					Requester: &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"*"}, RequireRBACBinding: ptr.To(true)},
+				}},
+			}},
+			expPolicies: nil,
+		},
 		"if single CertificateRequestPolicy bound at cluster level, return policy": {
 			apiObjects: []client.Object{
 				&rbacv1.ClusterRole{
@@ -408,9 +482,13 @@ func Test_RBACBound(t *testing.T) {
 					},
 				},
 			}
-			policies, err := RBACBound(env.AdminClient)(context.TODO(), req, test.policies)
-			assert.NoError(t, err)
-			assert.Equal(t, test.expPolicies, policies)
+			// The API server only uses the RBAC authorizer, so all modes should
+			// agree.
+			for _, mode := range RBACAuthorizationModes {
+				policies, err := RBACBound(env.AdminClient, env.AdminClient, mode)(context.TODO(), req, test.policies)
+				assert.NoError(t, err, mode)
+				assert.Equal(t, test.expPolicies, policies, mode)
+			}
 		})
 	}
 }
@@ -508,6 +586,29 @@ func Test_Ready(t *testing.T) {
 	}
 }
 
+func Test_Active(t *testing.T) {
+	now := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	policy := func(name string, spec policyapi.CertificateRequestPolicySpec) policyapi.CertificateRequestPolicy {
+		return policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+
+	var (
+		always      = policy("always", policyapi.CertificateRequestPolicySpec{})
+		valid       = policy("valid", policyapi.CertificateRequestPolicySpec{ValidUntil: &metav1.Time{Time: now.Add(time.Hour)}})
+		expired     = policy("expired", policyapi.CertificateRequestPolicySpec{ValidUntil: &metav1.Time{Time: now}})
+		notYet      = policy("not-yet", policyapi.CertificateRequestPolicySpec{ValidFrom: &metav1.Time{Time: now.Add(time.Second)}})
+		inWindow    = policy("in-window", policyapi.CertificateRequestPolicySpec{Schedule: &policyapi.CertificateRequestPolicySchedule{Windows: []policyapi.CertificateRequestPolicyScheduleWindow{{Start: "09:00", End: "17:00"}}}})
+		outWindow   = policy("out-window", policyapi.CertificateRequestPolicySpec{Schedule: &policyapi.CertificateRequestPolicySchedule{Windows: []policyapi.CertificateRequestPolicyScheduleWindow{{Start: "17:00", End: "09:00"}}}})
+		badTimeZone = policy("bad-time-zone", policyapi.CertificateRequestPolicySpec{Schedule: &policyapi.CertificateRequestPolicySchedule{TimeZone: ptr.To("Mars/Olympus_Mons"), Windows: []policyapi.CertificateRequestPolicyScheduleWindow{{Start: "00:00", End: "00:00"}}}})
+	)
+
+	policies, err := Active(fakeclock.NewFakePassiveClock(now))(context.TODO(), nil, []policyapi.CertificateRequestPolicy{
+		always, valid, expired, notYet, inWindow, outWindow, badTimeZone,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []policyapi.CertificateRequestPolicy{always, valid, inWindow}, policies)
+}
+
 func Test_SelectorIssuerRef(t *testing.T) {
 	baseRequest := &cmapi.CertificateRequest{
 		Spec: cmapi.CertificateRequestSpec{
@@ -716,6 +817,66 @@ func Test_SelectorIssuerRef(t *testing.T) {
 				}},
 			},
 		},
+		"if issuer name cannot be normalised in DNS mode, only Deny policies match": {
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{IssuerRef: cmmeta.ObjectReference{
+				Name: "xn--a.issuer",
+			}}},
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*.issuer"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Effect: policyapi.CertificateRequestPolicyEffectDeny,
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*.issuer"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Effect: policyapi.CertificateRequestPolicyEffectDeny,
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*.issuer"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+			},
+		},
+		"if policies match name and group in DNS mode, return matching policies": {
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{IssuerRef: cmmeta.ObjectReference{
+				Name: "issuer.team-a", Group: "issuers.example.com",
+			}}},
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("issuer.*"), Group: ptr.To("**.Example.com."), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*"), MatchMode: policyapi.CertificateRequestPolicyMatchModeGlob,
+					}},
+				}},
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("issuer.*"), Group: ptr.To("**.Example.com."), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+					}},
+				}},
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+						Name: ptr.To("*"), MatchMode: policyapi.CertificateRequestPolicyMatchModeGlob,
+					}},
+				}},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -732,6 +893,168 @@ func Test_SelectorIssuerRef(t *testing.T) {
 	}
 }
 
+func Test_SelectorRequestMetadata(t *testing.T) {
+	request := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app.kubernetes.io/managed-by": "ingress-controller"},
+			Annotations: map[string]string{"cert-manager.io/certificate-name": "my-cert"},
+		},
+	}
+
+	policyWithSelector := func(name string, requestLabels, requestAnnotations *metav1.LabelSelector) policyapi.CertificateRequestPolicy {
+		return policyapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: policyapi.CertificateRequestPolicySpec{
+				Selector: policyapi.CertificateRequestPolicySelector{
+					RequestLabels:      requestLabels,
+					RequestAnnotations: requestAnnotations,
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		policies    []policyapi.CertificateRequestPolicy
+		expPolicies []policyapi.CertificateRequestPolicy
+		expErr      bool
+	}{
+		"if no policies given, return no policies": {
+			policies:    nil,
+			expPolicies: nil,
+		},
+		"if policy has no request selectors, return policy": {
+			policies:    []policyapi.CertificateRequestPolicy{policyWithSelector("a", nil, nil)},
+			expPolicies: []policyapi.CertificateRequestPolicy{policyWithSelector("a", nil, nil)},
+		},
+		"if policy has empty request selectors, return policy": {
+			policies:    []policyapi.CertificateRequestPolicy{policyWithSelector("a", &metav1.LabelSelector{}, &metav1.LabelSelector{})},
+			expPolicies: []policyapi.CertificateRequestPolicy{policyWithSelector("a", &metav1.LabelSelector{}, &metav1.LabelSelector{})},
+		},
+		"if policies given, return only those whose request selectors match": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithSelector("a", &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/managed-by": "ingress-controller"}}, nil),
+				policyWithSelector("b", &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/managed-by": "mesh"}}, nil),
+				policyWithSelector("c", nil, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "cert-manager.io/certificate-name", Operator: metav1.LabelSelectorOpExists},
+				}}),
+				policyWithSelector("d", &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/managed-by": "ingress-controller"}}, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "cert-manager.io/certificate-name", Operator: metav1.LabelSelectorOpDoesNotExist},
+				}}),
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithSelector("a", &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/managed-by": "ingress-controller"}}, nil),
+				policyWithSelector("c", nil, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "cert-manager.io/certificate-name", Operator: metav1.LabelSelectorOpExists},
+				}}),
+			},
+		},
+		"if policy has an invalid request selector, return error": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithSelector("a", &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "foo", Operator: "Equals"},
+				}}, nil),
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policies, err := SelectorRequestMetadata(context.TODO(), request, test.policies)
+			assert.Equal(t, test.expErr, err != nil, "%v", err)
+			if !test.expErr && !apiequality.Semantic.DeepEqual(test.expPolicies, policies) {
+				t.Errorf("unexpected policies returned:\nexp=%#+v\ngot=%#+v", test.expPolicies, policies)
+			}
+		})
+	}
+}
+
+func Test_SelectorRequester(t *testing.T) {
+	policyWithRequester := func(name string, requester *policyapi.CertificateRequestPolicySelectorRequester) policyapi.CertificateRequestPolicy {
+		return policyapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: policyapi.CertificateRequestPolicySpec{
+				Selector: policyapi.CertificateRequestPolicySelector{Requester: requester},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		username    string
+		groups      []string
+		policies    []policyapi.CertificateRequestPolicy
+		expPolicies []policyapi.CertificateRequestPolicy
+	}{
+		"if no policies given, return no policies": {
+			username:    "user-1",
+			policies:    nil,
+			expPolicies: nil,
+		},
+		"if policies have no requester selector, return policies": {
+			username: "user-1",
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", nil),
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", nil),
+			},
+		},
+		"if policies match on username, return only matching policies": {
+			username: "user-1",
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"user-*"}}),
+				policyWithRequester("b", &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"user-2"}}),
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"user-*"}}),
+			},
+		},
+		"if policies match on any group, return only matching policies": {
+			username: "user-1",
+			groups:   []string{"system:authenticated", "team-a"},
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{Groups: []string{"team-*"}}),
+				policyWithRequester("b", &policyapi.CertificateRequestPolicySelectorRequester{Groups: []string{"team-b"}}),
+				policyWithRequester("c", &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"user-2"}, Groups: []string{"team-a"}}),
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{Groups: []string{"team-*"}}),
+				policyWithRequester("c", &policyapi.CertificateRequestPolicySelectorRequester{Usernames: []string{"user-2"}, Groups: []string{"team-a"}}),
+			},
+		},
+		"if policies match on service account, return only matching policies": {
+			username: "system:serviceaccount:sandbox:my-app",
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{{Namespace: "sandbox", Name: "*"}}}),
+				policyWithRequester("b", &policyapi.CertificateRequestPolicySelectorRequester{ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{{Namespace: "prod", Name: "my-app"}}}),
+			},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{{Namespace: "sandbox", Name: "*"}}}),
+			},
+		},
+		"if requester is not a service account, don't match on service accounts": {
+			username: "user-1",
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithRequester("a", &policyapi.CertificateRequestPolicySelectorRequester{ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{{Namespace: "*", Name: "*"}}}),
+			},
+			expPolicies: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := &cmapi.CertificateRequest{
+				Spec: cmapi.CertificateRequestSpec{Username: test.username, Groups: test.groups},
+			}
+			policies, err := SelectorRequester(context.TODO(), request, test.policies)
+			assert.NoError(t, err)
+			if !apiequality.Semantic.DeepEqual(test.expPolicies, policies) {
+				t.Errorf("unexpected policies returned:\nexp=%#+v\ngot=%#+v", test.expPolicies, policies)
+			}
+		})
+	}
+}
+
 func Test_SelectorNamespace(t *testing.T) {
 	var (
 		baseRequest = &cmapi.CertificateRequest{
@@ -1039,6 +1362,83 @@ func Test_SelectorNamespace(t *testing.T) {
 			existingNamespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace", Labels: map[string]string{"foo": "bar"}}},
 			expErr:            false,
 		},
+		"if policy given that matches match expressions, return policy": {
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"sandbox"}},
+							{Key: "team", Operator: metav1.LabelSelectorOpExists},
+						},
+					}},
+				}},
+			},
+			existingNamespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace", Labels: map[string]string{"tier": "prod", "team": "a"}}},
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"sandbox"}},
+							{Key: "team", Operator: metav1.LabelSelectorOpExists},
+						},
+					}},
+				}},
+			},
+			expErr: false,
+		},
+		"if policy given that doesn't match match expressions, return no policies": {
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"sandbox"}},
+						},
+					}},
+				}},
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						MatchLabels: map[string]string{"team": "a"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "restricted", Operator: metav1.LabelSelectorOpDoesNotExist},
+						},
+					}},
+				}},
+			},
+			existingNamespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace", Labels: map[string]string{"tier": "sandbox", "team": "a", "restricted": ""}}},
+			expPolicies:       nil,
+			expErr:            false,
+		},
+		"if policy given that matches an exclude name, return no policies": {
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						MatchNames:   []string{"test-*"},
+						ExcludeNames: []string{"kube-*", "*-namespace"},
+					}},
+				}},
+			},
+			existingNamespace: testns,
+			expPolicies:       nil,
+			expErr:            false,
+		},
+		"if policy given that doesn't match an exclude name, return policy": {
+			policies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						ExcludeNames: []string{"kube-*"},
+					}},
+				}},
+			},
+			existingNamespace: testns,
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				{Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+						ExcludeNames: []string{"kube-*"},
+					}},
+				}},
+			},
+			expErr: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -1058,3 +1458,109 @@ func Test_SelectorNamespace(t *testing.T) {
 		})
 	}
 }
+
+func Test_SelectorExpression(t *testing.T) {
+	var (
+		baseRequest = &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "test-namespace",
+				Annotations: map[string]string{"cert-manager.io/certificate-name": "my-cert"},
+			},
+			Spec: cmapi.CertificateRequestSpec{
+				Username:  "system:serviceaccount:cert-manager:cert-manager",
+				IssuerRef: cmmeta.ObjectReference{Name: "my-issuer"},
+			},
+		}
+		testns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace", Labels: map[string]string{"team": "a"}}}
+
+		policyWithExpression = func(name, expr string) policyapi.CertificateRequestPolicy {
+			return policyapi.CertificateRequestPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{Expression: ptr.To(expr)},
+				},
+			}
+		}
+	)
+
+	tests := map[string]struct {
+		policies          []policyapi.CertificateRequestPolicy
+		existingNamespace runtime.Object
+		expPolicies       []policyapi.CertificateRequestPolicy
+		expErr            bool
+	}{
+		"if no policies given, return no policies": {
+			policies:          nil,
+			existingNamespace: testns,
+			expPolicies:       nil,
+			expErr:            false,
+		},
+		"if policy has no expression, return policy without fetching namespace": {
+			policies: []policyapi.CertificateRequestPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+			},
+			existingNamespace: nil,
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+			},
+			expErr: false,
+		},
+		"if namespace for request doesn't exist and using expression, expect error": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithExpression("a", "true"),
+			},
+			existingNamespace: nil,
+			expPolicies:       nil,
+			expErr:            true,
+		},
+		"if policies given, return only those whose expression evaluates to true": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithExpression("a", "'cert-manager.io/certificate-name' in cr.annotations"),
+				policyWithExpression("b", "cr.issuerRef.name == 'other-issuer'"),
+				policyWithExpression("c", "namespaceLabels['team'] == 'a' && serviceAccount(cr.username).getNamespace() == 'cert-manager'"),
+				policyWithExpression("d", "namespaceLabels['team'] == 'b'"),
+			},
+			existingNamespace: testns,
+			expPolicies: []policyapi.CertificateRequestPolicy{
+				policyWithExpression("a", "'cert-manager.io/certificate-name' in cr.annotations"),
+				policyWithExpression("c", "namespaceLabels['team'] == 'a' && serviceAccount(cr.username).getNamespace() == 'cert-manager'"),
+			},
+			expErr: false,
+		},
+		"if expression fails to compile, expect error": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithExpression("a", "true"),
+				policyWithExpression("b", "cr.name"),
+			},
+			existingNamespace: testns,
+			expPolicies:       nil,
+			expErr:            true,
+		},
+		"if expression fails to evaluate, expect error": {
+			policies: []policyapi.CertificateRequestPolicy{
+				policyWithExpression("a", "true"),
+				policyWithExpression("b", "namespaceLabels['foo'] == 'bar'"),
+			},
+			existingNamespace: testns,
+			expPolicies:       nil,
+			expErr:            true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := fakeclient.NewClientBuilder().
+				WithScheme(policyapi.GlobalScheme)
+			if test.existingNamespace != nil {
+				builder = builder.WithRuntimeObjects(test.existingNamespace)
+			}
+			fakeclient := builder.Build()
+
+			policies, err := SelectorExpression(fakeclient, validation.NewCache())(context.TODO(), baseRequest, test.policies)
+			assert.Equal(t, err != nil, test.expErr, "%v", err)
+			if !test.expErr && !apiequality.Semantic.DeepEqual(test.expPolicies, policies) {
+				t.Errorf("unexpected policies returned:\nexp=%#+v\ngot=%#+v", test.expPolicies, policies)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RBACAuthorizationMode defines how the RBACBound Predicate determines
+// whether a CertificateRequestPolicy is bound to the user in a
+// CertificateRequest.
+type RBACAuthorizationMode string
+
+const (
+	// RBACAuthorizationModeInProcess evaluates the RBAC Roles, RoleBindings,
+	// ClusterRoles and ClusterRoleBindings in the informer cache, without
+	// making any requests to the API server. Only use when the API server
+	// authorizes requests with RBAC alone.
+	RBACAuthorizationModeInProcess RBACAuthorizationMode = "in-process"
+
+	// RBACAuthorizationModeInProcessWithFallback evaluates RBAC in process,
+	// and falls back to creating a SubjectAccessReview if in process RBAC
+	// doesn't bind the policy to the user, so that policies bound by
+	// authorizers other than RBAC, such as webhook authorizers, are still
+	// bound. This is the default mode.
+	RBACAuthorizationModeInProcessWithFallback RBACAuthorizationMode = "in-process-with-fallback"
+
+	// RBACAuthorizationModeSubjectAccessReview creates a SubjectAccessReview
+	// for every policy.
+	RBACAuthorizationModeSubjectAccessReview RBACAuthorizationMode = "subject-access-review"
+)
+
+// RBACAuthorizationModes are all supported RBACAuthorizationModes.
+var RBACAuthorizationModes = []RBACAuthorizationMode{
+	RBACAuthorizationModeInProcess,
+	RBACAuthorizationModeInProcessWithFallback,
+	RBACAuthorizationModeSubjectAccessReview,
+}
+
+// rbacAuthorizer is a minimal RBAC authorizer which determines whether users
+// may "use" CertificateRequestPolicies, from the RBAC resources in the
+// informer cache. It implements the same rules as the Kubernetes RBAC
+// authorizer for resource requests.
+type rbacAuthorizer struct {
+	lister client.Reader
+}
+
+// roleKey identifies a Role or ClusterRole. namespace is empty for
+// ClusterRoles.
+type roleKey struct {
+	kind, namespace, name string
+}
+
+// userRules returns the rules of all Roles and ClusterRoles which are bound
+// to the user in the request, in the namespace of the request. The rules are
+// resolved once per request, and then matched against each policy with
+// rulesAllow. The returned rules are shared with the informer cache and must
+// not be modified.
+func (r rbacAuthorizer) userRules(ctx context.Context, cr *cmapi.CertificateRequest) ([]rbacv1.PolicyRule, error) {
+	var (
+		rules []rbacv1.PolicyRule
+		// resolved are the roles whose rules have already been collected, so
+		// roles bound by multiple bindings are only fetched once.
+		resolved = make(map[roleKey]struct{})
+	)
+
+	collect := func(ref rbacv1.RoleRef, namespace string) error {
+		key := roleKey{kind: ref.Kind, namespace: namespace, name: ref.Name}
+		if _, ok := resolved[key]; ok {
+			return nil
+		}
+		resolved[key] = struct{}{}
+
+		roleRules, err := r.roleRefRules(ctx, ref, namespace)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, roleRules...)
+		return nil
+	}
+
+	var clusterRoleBindings rbacv1.ClusterRoleBindingList
+	if err := r.lister.List(ctx, &clusterRoleBindings, client.UnsafeDisableDeepCopy); err != nil {
+		return nil, fmt.Errorf("failed to list clusterrolebindings: %w", err)
+	}
+
+	for _, binding := range clusterRoleBindings.Items {
+		if !bindingAppliesToUser(cr, binding.Subjects, "") {
+			continue
+		}
+		if err := collect(binding.RoleRef, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	var roleBindings rbacv1.RoleBindingList
+	if err := r.lister.List(ctx, &roleBindings, client.InNamespace(cr.Namespace), client.UnsafeDisableDeepCopy); err != nil {
+		return nil, fmt.Errorf("failed to list rolebindings: %w", err)
+	}
+
+	for _, binding := range roleBindings.Items {
+		if !bindingAppliesToUser(cr, binding.Subjects, binding.Namespace) {
+			continue
+		}
+		namespace := binding.Namespace
+		if binding.RoleRef.Kind == "ClusterRole" {
+			namespace = ""
+		}
+		if err := collect(binding.RoleRef, namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+// roleRefRules returns the rules of the referenced Role or ClusterRole. Roles
+// which don't exist have no rules.
+func (r rbacAuthorizer) roleRefRules(ctx context.Context, ref rbacv1.RoleRef, namespace string) ([]rbacv1.PolicyRule, error) {
+	switch ref.Kind {
+	case "ClusterRole":
+		var role rbacv1.ClusterRole
+		if err := r.lister.Get(ctx, client.ObjectKey{Name: ref.Name}, &role); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return role.Rules, nil
+
+	case "Role":
+		var role rbacv1.Role
+		if err := r.lister.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &role); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return role.Rules, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// rulesAllow returns true if any of the rules allows using the named
+// CertificateRequestPolicy.
+func rulesAllow(rules []rbacv1.PolicyRule, policyName string) bool {
+	return slices.ContainsFunc(rules, func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, policyName)
+	})
+}
+
+// bindingAppliesToUser returns true if any of the subjects of a binding
+// refer to the user in the request. namespace is the namespace of the
+// binding, and is used to default the namespace of ServiceAccount subjects.
+func bindingAppliesToUser(cr *cmapi.CertificateRequest, subjects []rbacv1.Subject, namespace string) bool {
+	return slices.ContainsFunc(subjects, func(subject rbacv1.Subject) bool {
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			return subject.Name == cr.Spec.Username
+
+		case rbacv1.GroupKind:
+			return slices.Contains(cr.Spec.Groups, subject.Name)
+
+		case rbacv1.ServiceAccountKind:
+			saNamespace := namespace
+			if len(subject.Namespace) > 0 {
+				saNamespace = subject.Namespace
+			}
+			if len(saNamespace) == 0 {
+				return false
+			}
+			return serviceaccount.MatchesUsername(saNamespace, subject.Name, cr.Spec.Username)
+
+		default:
+			return false
+		}
+	})
+}
+
+// ruleAllows returns true if the rule allows the "use" verb on the named
+// CertificateRequestPolicy.
+func ruleAllows(rule rbacv1.PolicyRule, policyName string) bool {
+	return containsOrAll(rule.Verbs, "use") &&
+		containsOrAll(rule.APIGroups, "policy.cert-manager.io") &&
+		containsOrAll(rule.Resources, "certificaterequestpolicies") &&
+		(len(rule.ResourceNames) == 0 || slices.Contains(rule.ResourceNames, policyName))
+}
+
+// containsOrAll returns true if the given values contain the value, or the
+// "*" value which matches everything.
+func containsOrAll(values []string, value string) bool {
+	return slices.Contains(values, value) || slices.Contains(values, rbacv1.ResourceAll)
+}
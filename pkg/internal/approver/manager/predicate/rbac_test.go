@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/stretchr/testify/assert"
+	authzv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+func Test_rbacAuthorizer(t *testing.T) {
+	const (
+		requestNamespace = "test-namespace"
+		policyName       = "test-policy"
+	)
+
+	useRule := rbacv1.PolicyRule{
+		Verbs:     []string{"use"},
+		APIGroups: []string{"policy.cert-manager.io"},
+		Resources: []string{"certificaterequestpolicies"},
+	}
+
+	clusterRole := func(name string, rules ...rbacv1.PolicyRule) *rbacv1.ClusterRole {
+		return &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}, Rules: rules}
+	}
+	role := func(namespace, name string, rules ...rbacv1.PolicyRule) *rbacv1.Role {
+		return &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Rules: rules}
+	}
+	clusterRoleBinding := func(roleName string, subjects ...rbacv1.Subject) *rbacv1.ClusterRoleBinding {
+		return &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-binding"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: roleName},
+			Subjects:   subjects,
+		}
+	}
+	roleBinding := func(namespace, roleKind, roleName string, subjects ...rbacv1.Subject) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "test-binding"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: roleKind, Name: roleName},
+			Subjects:   subjects,
+		}
+	}
+
+	userSubject := rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "example"}
+
+	tests := map[string]struct {
+		username string
+		groups   []string
+		objects  []client.Object
+		expAllow bool
+	}{
+		"if no RBAC exists, should not allow": {
+			username: "example",
+			expAllow: false,
+		},
+		"if user is bound to a ClusterRole with a ClusterRoleBinding, should allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", useRule),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: true,
+		},
+		"if a different user is bound to a ClusterRole, should not allow": {
+			username: "other",
+			objects: []client.Object{
+				clusterRole("test-role", useRule),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: false,
+		},
+		"if the bound ClusterRole doesn't exist, should not allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: false,
+		},
+		"if group is bound to a ClusterRole, should allow": {
+			username: "example",
+			groups:   []string{"group-1", "group-2"},
+			objects: []client.Object{
+				clusterRole("test-role", useRule),
+				clusterRoleBinding("test-role", rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "group-2"}),
+			},
+			expAllow: true,
+		},
+		"if ServiceAccount is bound to a Role in the request namespace, should allow": {
+			username: "system:serviceaccount:test-namespace:test-sa",
+			objects: []client.Object{
+				role(requestNamespace, "test-role", useRule),
+				roleBinding(requestNamespace, "Role", "test-role", rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "test-sa"}),
+			},
+			expAllow: true,
+		},
+		"if ServiceAccount in another namespace is bound to a Role in the request namespace, should allow": {
+			username: "system:serviceaccount:other-namespace:test-sa",
+			objects: []client.Object{
+				role(requestNamespace, "test-role", useRule),
+				roleBinding(requestNamespace, "Role", "test-role", rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "other-namespace", Name: "test-sa"}),
+			},
+			expAllow: true,
+		},
+		"if user is bound to a ClusterRole with a RoleBinding in the request namespace, should allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", useRule),
+				roleBinding(requestNamespace, "ClusterRole", "test-role", userSubject),
+			},
+			expAllow: true,
+		},
+		"if user is bound to a Role in another namespace, should not allow": {
+			username: "example",
+			objects: []client.Object{
+				role("other-namespace", "test-role", useRule),
+				roleBinding("other-namespace", "Role", "test-role", userSubject),
+			},
+			expAllow: false,
+		},
+		"if rule uses wildcards, should allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", rbacv1.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: true,
+		},
+		"if rule has a different verb, should not allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: useRule.APIGroups, Resources: useRule.Resources}),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: false,
+		},
+		"if rule has a matching resource name, should allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", rbacv1.PolicyRule{Verbs: useRule.Verbs, APIGroups: useRule.APIGroups, Resources: useRule.Resources, ResourceNames: []string{policyName}}),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: true,
+		},
+		"if rule has a different resource name, should not allow": {
+			username: "example",
+			objects: []client.Object{
+				clusterRole("test-role", rbacv1.PolicyRule{Verbs: useRule.Verbs, APIGroups: useRule.APIGroups, Resources: useRule.Resources, ResourceNames: []string{"other-policy"}}),
+				clusterRoleBinding("test-role", userSubject),
+			},
+			expAllow: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			lister := fakeclient.NewClientBuilder().
+				WithScheme(policyapi.GlobalScheme).
+				WithObjects(test.objects...).
+				Build()
+
+			req := &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: requestNamespace},
+				Spec:       cmapi.CertificateRequestSpec{Username: test.username, Groups: test.groups},
+			}
+
+			rules, err := rbacAuthorizer{lister: lister}.userRules(context.TODO(), req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expAllow, rulesAllow(rules, policyName))
+		})
+	}
+}
+
+func Test_RBACBound_Modes(t *testing.T) {
+	policies := []policyapi.CertificateRequestPolicy{
+		{ObjectMeta: metav1.ObjectMeta{Name: "rbac-bound"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "sar-bound"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "not-bound"}},
+	}
+
+	lister := fakeclient.NewClientBuilder().
+		WithScheme(policyapi.GlobalScheme).
+		WithObjects(
+			&rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-role"},
+				Rules: []rbacv1.PolicyRule{{
+					Verbs:         []string{"use"},
+					APIGroups:     []string{"policy.cert-manager.io"},
+					Resources:     []string{"certificaterequestpolicies"},
+					ResourceNames: []string{"rbac-bound"},
+				}},
+			},
+			&rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-binding"},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "test-role"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "example"}},
+			},
+		).
+		Build()
+
+	tests := map[string]struct {
+		mode        RBACAuthorizationMode
+		expPolicies []string
+		expSARs     []string
+	}{
+		"in-process": {
+			mode:        RBACAuthorizationModeInProcess,
+			expPolicies: []string{"rbac-bound"},
+			expSARs:     nil,
+		},
+		"in-process-with-fallback": {
+			mode:        RBACAuthorizationModeInProcessWithFallback,
+			expPolicies: []string{"rbac-bound", "sar-bound"},
+			expSARs:     []string{"sar-bound", "not-bound"},
+		},
+		"subject-access-review": {
+			mode:        RBACAuthorizationModeSubjectAccessReview,
+			expPolicies: []string{"sar-bound"},
+			expSARs:     []string{"rbac-bound", "sar-bound", "not-bound"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// SubjectAccessReviews model an authorizer which only binds the
+			// "sar-bound" policy.
+			var sars []string
+			sarClient := fakeclient.NewClientBuilder().
+				WithScheme(policyapi.GlobalScheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+						rev := obj.(*authzv1.SubjectAccessReview)
+						sars = append(sars, rev.Spec.ResourceAttributes.Name)
+						rev.Status.Allowed = rev.Spec.ResourceAttributes.Name == "sar-bound"
+						return nil
+					},
+				}).
+				Build()
+
+			req := &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace"},
+				Spec:       cmapi.CertificateRequestSpec{Username: "example"},
+			}
+
+			boundPolicies, err := RBACBound(sarClient, lister, test.mode)(context.TODO(), req, policies)
+			assert.NoError(t, err)
+
+			var names []string
+			for _, policy := range boundPolicies {
+				names = append(names, policy.Name)
+			}
+			assert.Equal(t, test.expPolicies, names)
+			assert.Equal(t, test.expSARs, sars)
+		})
+	}
+}
+
+func Test_RBACBound_ResolvesRBACOnce(t *testing.T) {
+	var policies []policyapi.CertificateRequestPolicy
+	for _, name := range []string{"policy-a", "policy-b", "policy-c"} {
+		policies = append(policies, policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	var lists, gets int
+	lister := fakeclient.NewClientBuilder().
+		WithScheme(policyapi.GlobalScheme).
+		WithObjects(
+			&rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-role"},
+				Rules: []rbacv1.PolicyRule{{
+					Verbs:         []string{"use"},
+					APIGroups:     []string{"policy.cert-manager.io"},
+					Resources:     []string{"certificaterequestpolicies"},
+					ResourceNames: []string{"policy-b"},
+				}},
+			},
+			&rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-binding"},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "test-role"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "example"}},
+			},
+			&rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-binding"},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "test-role"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "example"}},
+			},
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				lists++
+				return c.List(ctx, list, opts...)
+			},
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				gets++
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	req := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace"},
+		Spec:       cmapi.CertificateRequestSpec{Username: "example"},
+	}
+
+	boundPolicies, err := RBACBound(nil, lister, RBACAuthorizationModeInProcess)(context.TODO(), req, policies)
+	assert.NoError(t, err)
+	assert.Equal(t, []policyapi.CertificateRequestPolicy{policies[1]}, boundPolicies)
+	// One list of ClusterRoleBindings and RoleBindings, and one get of the
+	// ClusterRole bound by both bindings.
+	assert.Equal(t, 2, lists)
+	assert.Equal(t, 1, gets)
+}
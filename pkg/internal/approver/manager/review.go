@@ -21,14 +21,20 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
 	"github.com/cert-manager/approver-policy/pkg/approver/manager"
 	"github.com/cert-manager/approver-policy/pkg/internal/approver/manager/predicate"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 var _ manager.Interface = &mngr{}
@@ -40,6 +46,36 @@ type mngr struct {
 	lister     client.Reader
 	predicates []predicate.Predicate
 	evaluators []approver.Evaluator
+
+	// concurrency is the maximum number of policies that are evaluated
+	// concurrently during a single Review.
+	concurrency int
+
+	// indexed is true if the lister has the field indexes registered by
+	// RegisterIndexes, and so can be used to look up candidate policies.
+	indexed bool
+}
+
+// evaluation holds the result of running every evaluator against a single
+// policy.
+type evaluation struct {
+	// denied is true if any evaluator denied the request.
+	denied bool
+
+	// message is the aggregated messages returned from the evaluators.
+	message string
+
+	// requeueAfter is the longest duration any evaluator asked for the
+	// request to be reviewed again after, if no evaluator denied it.
+	requeueAfter time.Duration
+
+	// pending is true if any evaluator asked for the request to be left
+	// pending, if no evaluator denied it.
+	pending bool
+
+	// terminal is true if any evaluator denied the request outright, so that
+	// no other policy may approve it.
+	terminal bool
 }
 
 // policyMessage holds the name of the CertificateRequestPolicy and aggregated
@@ -59,44 +95,84 @@ type policyMessage struct {
 // evaluators.
 // CertificateRequestPolicies will be filtered on Review for evaluation with the predicates:
 //   - CertificateRequestPolicy is ready
+//   - CertificateRequestPolicy is active, according to its validity period
+//     and schedule
 //   - CertificateRequestPolicy Selector.IssuerRef matches the CertificateRequest
 //
 // IssuerRef
-//   - CertificateRequestPolicy is bound to the user that appears in the
+//   - CertificateRequestPolicy Selector.RequestLabels and
+//     Selector.RequestAnnotations match the metadata of the CertificateRequest
+//   - CertificateRequestPolicy Selector.Namespace matches the namespace of the
 //     CertificateRequest
-func New(lister client.Reader, client client.Client, evaluators []approver.Evaluator) manager.Interface {
+//   - CertificateRequestPolicy Selector.Expression evaluates to true for the
+//     CertificateRequest
+//   - CertificateRequestPolicy Selector.Requester matches the user that
+//     appears in the CertificateRequest
+//   - CertificateRequestPolicy is bound to the user that appears in the
+//     CertificateRequest, unless it selects the requester. How RBAC is
+//     evaluated is determined by the rbacMode
+//
+// At most concurrency policies are evaluated concurrently during a Review.
+// If indexed is true, the lister must have the field indexes registered by
+// RegisterIndexes, which are used to look up candidate policies. Otherwise,
+// every policy is listed and filtered by the predicates on each Review.
+func New(lister client.Reader, indexed bool, client client.Client, rbacMode predicate.RBACAuthorizationMode, concurrency int, evaluators []approver.Evaluator) manager.Interface {
 	return &mngr{
 		lister: lister,
 		predicates: []predicate.Predicate{
 			predicate.Ready,
+			predicate.Active(clock.RealClock{}),
 			predicate.SelectorIssuerRef,
+			predicate.SelectorRequestMetadata,
 			predicate.SelectorNamespace(lister),
-			predicate.RBACBound(client),
+			predicate.SelectorExpression(lister, validation.NewCache()),
+			predicate.SelectorRequester,
+			predicate.RBACBound(client, lister, rbacMode),
 		},
-		evaluators: evaluators,
+		evaluators:  evaluators,
+		concurrency: max(concurrency, 1),
+		indexed:     indexed,
 	}
 }
 
 // Review will evaluate whether the incoming CertificateRequest should be
 // approved. All evaluators will be called with CertificateRequestPolicys that
-// have passed all of the predicates.
+// have passed all of the predicates. Deny CertificateRequestPolicies are
+// evaluated first, and deny the request if any of them match.
+// NamespacedCertificateRequestPolicies in the namespace of the request are
+// evaluated alongside CertificateRequestPolicies. If any of them are
+// appropriate for the request, then the request must be approved by both a
+// CertificateRequestPolicy and a NamespacedCertificateRequestPolicy.
+// Policies are evaluated concurrently, but their results are considered in
+// order of descending priority, then by name, so that the policy which
+// approves or denies a request is deterministic. An allow policy whose
+// evaluation denies the request outright, such as by a manual deny vote,
+// denies the request in the same way as a matching Deny policy.
+// Audit policies never affect the result of the review. The decisions they
+// would have made are returned in the Audits of the response.
 func (m *mngr) Review(ctx context.Context, cr *cmapi.CertificateRequest) (manager.ReviewResponse, error) {
-	policyList := new(policyapi.CertificateRequestPolicyList)
-	if err := m.lister.List(ctx, policyList); err != nil {
+	policies, namespacedPolicies, err := m.listPolicies(ctx, cr)
+	if err != nil {
 		return manager.ReviewResponse{}, err
 	}
 
 	// If no CertificateRequestPolicies exist in the cluster, return
 	// ResultUnprocessed. A CertificateRequest may be re-evaluated at a later
 	// time if a CertificateRequestPolicy is created.
-	if len(policyList.Items) == 0 {
-		return manager.ReviewResponse{Result: manager.ResultUnprocessed, Message: "No CertificateRequestPolicies exist"}, nil
+	if len(policies) == 0 {
+		exist, err := m.policiesExist(ctx)
+		if err != nil {
+			return manager.ReviewResponse{}, err
+		}
+		if !exist {
+			return manager.ReviewResponse{Result: manager.ResultUnprocessed, Message: "No CertificateRequestPolicies exist"}, nil
+		}
+	}
+
+	for i := range namespacedPolicies {
+		policies = append(policies, *util.CertificateRequestPolicyFromNamespaced(&namespacedPolicies[i]))
 	}
 
-	var (
-		policies = policyList.Items
-		err      error
-	)
 	for _, predicate := range m.predicates {
 		policies, err = predicate(ctx, cr, policies)
 		if err != nil {
@@ -104,57 +180,242 @@ func (m *mngr) Review(ctx context.Context, cr *cmapi.CertificateRequest) (manage
 		}
 	}
 
-	// If no policies are appropriate, return ResultUnprocessed.
-	if len(policies) == 0 {
+	// Audit policies are evaluated separately to enforced policies, and never
+	// affect the result of the review.
+	var enforcedPolicies, auditPolicies []policyapi.CertificateRequestPolicy
+	for _, policy := range policies {
+		if policy.Spec.Enforcement == policyapi.CertificateRequestPolicyEnforcementAudit {
+			auditPolicies = append(auditPolicies, policy)
+		} else {
+			enforcedPolicies = append(enforcedPolicies, policy)
+		}
+	}
+
+	audits, err := m.audit(ctx, auditPolicies, cr)
+	if err != nil {
+		return manager.ReviewResponse{}, err
+	}
+
+	response, err := m.review(ctx, enforcedPolicies, cr)
+	if err != nil {
+		return manager.ReviewResponse{}, err
+	}
+	response.Audits = audits
+
+	return response, nil
+}
+
+// review evaluates the given enforced policies, which have passed all
+// predicates, against the request and returns the result.
+func (m *mngr) review(ctx context.Context, policies []policyapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) (manager.ReviewResponse, error) {
+	// Split the policies into those that deny and those that allow requests.
+	// Allow policies are further split into CertificateRequestPolicies and
+	// NamespacedCertificateRequestPolicies.
+	var denyPolicies, allowPolicies, namespacedAllowPolicies []policyapi.CertificateRequestPolicy
+	for i := range policies {
+		switch policy := &policies[i]; {
+		case policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny:
+			denyPolicies = append(denyPolicies, *policy)
+		case util.IsNamespacedPolicy(policy):
+			namespacedAllowPolicies = append(namespacedAllowPolicies, *policy)
+		default:
+			allowPolicies = append(allowPolicies, *policy)
+		}
+	}
+
+	// Policies are evaluated in priority order so that the approving or
+	// denying policy is stable when more than one policy would decide the
+	// request.
+	sortPolicies(denyPolicies)
+	sortPolicies(allowPolicies)
+	sortPolicies(namespacedAllowPolicies)
+
+	// Deny policies are evaluated before any allow policy. A request which is
+	// matched by a deny policy is denied, regardless of whether an allow policy
+	// would approve it. If no evaluator denied the request, then the request
+	// matches the deny policy.
+	evaluations, denied, err := m.evaluateAll(ctx, denyPolicies, cr, func(e evaluation) bool { return !e.denied })
+	if err != nil {
+		return manager.ReviewResponse{}, err
+	}
+	if denied >= 0 {
+		return deniedResponse(&denyPolicies[denied], evaluations[denied]), nil
+	}
+
+	// If no allow policies are appropriate, return ResultUnprocessed.
+	// NamespacedCertificateRequestPolicies can only restrict what is approved
+	// by CertificateRequestPolicies, so are not considered here.
+	if len(allowPolicies) == 0 {
 		return manager.ReviewResponse{
 			Result:  manager.ResultUnprocessed,
 			Message: "No CertificateRequestPolicies bound or applicable",
 		}, nil
 	}
 
-	// policyMessages hold the aggregated messages of each evaluator response,
-	// keyed by the policy name that was executed.
-	var policyMessages []policyMessage
+	approvedBy, approval, policyMessages, err := m.evaluateAllow(ctx, allowPolicies, cr)
+	if err != nil {
+		return manager.ReviewResponse{}, err
+	}
+	if approval.terminal {
+		return deniedResponse(approvedBy, approval), nil
+	}
+	if approvedBy == nil {
+		// Return with all policies that we consulted, and their errors to why the
+		// request was denied.
+		return manager.ReviewResponse{
+			Result:  manager.ResultDenied,
+			Message: fmt.Sprintf("No policy approved this request: %s", policyMessages),
+		}, nil
+	}
+	if approval.pending || approval.requeueAfter > 0 {
+		return delayedResponse(approvedBy, approval), nil
+	}
 
-	// Run every evaluators against ever policy which is bound to the requesting
-	// user.
-	for _, policy := range policies {
-		var (
-			evaluatorDenied   bool
-			evaluatorMessages []string
-		)
-
-		for _, evaluator := range m.evaluators {
-			// #nosec G601 -- False positive. The function does not keep this pointer past its scope.
-			response, err := evaluator.Evaluate(ctx, &policy, cr)
-			if err != nil {
-				// if a single evaluator errors, then return early without trying
-				// others.
-				return manager.ReviewResponse{}, err
-			}
+	// If no NamespacedCertificateRequestPolicies are appropriate, the approval
+	// of the CertificateRequestPolicy stands.
+	if len(namespacedAllowPolicies) == 0 {
+		return manager.ReviewResponse{
+			Result:  manager.ResultApproved,
+			Message: fmt.Sprintf("Approved by CertificateRequestPolicy: %q", approvedBy.Name),
+		}, nil
+	}
 
-			if len(response.Message) > 0 {
-				evaluatorMessages = append(evaluatorMessages, response.Message)
-			}
+	namespacedApprovedBy, approval, policyMessages, err := m.evaluateAllow(ctx, namespacedAllowPolicies, cr)
+	if err != nil {
+		return manager.ReviewResponse{}, err
+	}
+	if approval.terminal {
+		return deniedResponse(namespacedApprovedBy, approval), nil
+	}
+	if namespacedApprovedBy == nil {
+		return manager.ReviewResponse{
+			Result:  manager.ResultDenied,
+			Message: fmt.Sprintf("No NamespacedCertificateRequestPolicy approved this request: %s", policyMessages),
+		}, nil
+	}
+	if approval.pending || approval.requeueAfter > 0 {
+		return delayedResponse(namespacedApprovedBy, approval), nil
+	}
+
+	return manager.ReviewResponse{
+		Result: manager.ResultApproved,
+		Message: fmt.Sprintf("Approved by CertificateRequestPolicy: %q and NamespacedCertificateRequestPolicy: %q",
+			approvedBy.Name, namespacedApprovedBy.Name),
+	}, nil
+}
+
+// deniedResponse returns the response for a request which the given policy
+// denied outright, either because it is a Deny policy which matched the
+// request, or because an evaluator denied the request with a terminal result.
+func deniedResponse(policy *policyapi.CertificateRequestPolicy, e evaluation) manager.ReviewResponse {
+	message := fmt.Sprintf("Denied by %s: %q", policyKind(policy), policy.Name)
+	if len(e.message) > 0 {
+		message = fmt.Sprintf("%s: %s", message, e.message)
+	}
+	return manager.ReviewResponse{
+		Result:  manager.ResultDenied,
+		Message: message,
+	}
+}
 
-			// evaluatorDenied will be set to true if any evaluator denies. We don't
-			// break early so that we can capture the responses from _all_
-			// evaluators.
-			if response.Result == approver.ResultDenied {
-				evaluatorDenied = true
+// delayedResponse returns the response for a request which the given policy
+// would approve, but whose evaluation asked for it to be reviewed again later,
+// or left it pending. The request is left unprocessed.
+func delayedResponse(policy *policyapi.CertificateRequestPolicy, e evaluation) manager.ReviewResponse {
+	message := fmt.Sprintf("Delayed by %s: %q", policyKind(policy), policy.Name)
+	if e.pending {
+		message = fmt.Sprintf("Pending approval for %s: %q", policyKind(policy), policy.Name)
+	}
+	if len(e.message) > 0 {
+		message = fmt.Sprintf("%s: %s", message, e.message)
+	}
+	return manager.ReviewResponse{
+		Result:       manager.ResultUnprocessed,
+		Message:      message,
+		RequeueAfter: e.requeueAfter,
+		Pending:      e.pending,
+	}
+}
+
+// audit evaluates the given Audit policies against the request, and returns
+// the decisions they would have made had they been enforced. Allow policies
+// report whether they would have approved or denied the request. Deny policies
+// are only reported when they would have denied the request.
+func (m *mngr) audit(ctx context.Context, policies []policyapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) ([]manager.AuditResponse, error) {
+	// Sort the policies so that the audit results are deterministic.
+	sortPolicies(policies)
+
+	// No single audit policy decides the outcome, so all are evaluated.
+	evaluations, _, err := m.evaluateAll(ctx, policies, cr, func(evaluation) bool { return false })
+	if err != nil {
+		return nil, err
+	}
+
+	var audits []manager.AuditResponse
+	for i := range policies {
+		audit := manager.AuditResponse{
+			Kind:    policyKind(&policies[i]),
+			Name:    policies[i].Name,
+			Message: evaluations[i].message,
+		}
+
+		switch {
+		case policies[i].Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny:
+			// Deny policies only have an effect when they match the request.
+			if evaluations[i].denied {
+				continue
 			}
+			audit.Result = manager.ResultDenied
+		case evaluations[i].denied:
+			audit.Result = manager.ResultDenied
+		default:
+			audit.Result = manager.ResultApproved
 		}
 
-		// If no evaluator denied the request, return with approved response.
-		if !evaluatorDenied {
-			return manager.ReviewResponse{
-				Result:  manager.ResultApproved,
-				Message: fmt.Sprintf("Approved by CertificateRequestPolicy: %q", policy.Name),
-			}, nil
+		audits = append(audits, audit)
+	}
+
+	return audits, nil
+}
+
+// sortPolicies sorts the given policies into the order they should be
+// evaluated. Policies are sorted by descending priority, then
+// CertificateRequestPolicies before NamespacedCertificateRequestPolicies, and
+// finally by name.
+func sortPolicies(policies []policyapi.CertificateRequestPolicy) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		if policies[i].Spec.Priority != policies[j].Spec.Priority {
+			return policies[i].Spec.Priority > policies[j].Spec.Priority
+		}
+		if policies[i].Namespace != policies[j].Namespace {
+			return policies[i].Namespace < policies[j].Namespace
 		}
+		return policies[i].Name < policies[j].Name
+	})
+}
 
-		// Collect evaluator messages that were executed for this policy.
-		policyMessages = append(policyMessages, policyMessage{name: policy.Name, message: strings.Join(evaluatorMessages, ", ")})
+// evaluateAllow runs every evaluator against each of the given allow policies.
+// Returns the first policy, in the given order, which approves the request or
+// denies it outright, along with its evaluation. If no policy does, returns
+// nil along with the aggregated evaluator messages of all policies, sorted by
+// policy name.
+func (m *mngr) evaluateAllow(ctx context.Context, policies []policyapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) (*policyapi.CertificateRequestPolicy, evaluation, string, error) {
+	// If no evaluator denied the request, then the policy approves it. A
+	// terminal denial decides the review, so that no lower priority policy may
+	// approve the request instead.
+	evaluations, approved, err := m.evaluateAll(ctx, policies, cr, func(e evaluation) bool { return !e.denied || e.terminal })
+	if err != nil {
+		return nil, evaluation{}, "", err
+	}
+	if approved >= 0 {
+		return &policies[approved], evaluations[approved], "", nil
+	}
+
+	// policyMessages hold the aggregated messages of each evaluator response,
+	// keyed by the policy name that was executed.
+	var policyMessages []policyMessage
+	for i := range policies {
+		policyMessages = append(policyMessages, policyMessage{name: policies[i].Name, message: evaluations[i].message})
 	}
 
 	// Sort messages by policy name and build message string.
@@ -166,10 +427,181 @@ func (m *mngr) Review(ctx context.Context, cr *cmapi.CertificateRequest) (manage
 		messages = append(messages, fmt.Sprintf("[%s: %s]", policyMessage.name, policyMessage.message))
 	}
 
-	// Return with all policies that we consulted, and their errors to why the
-	// request was denied.
-	return manager.ReviewResponse{
-		Result:  manager.ResultDenied,
-		Message: fmt.Sprintf("No policy approved this request: %s", strings.Join(messages, " ")),
+	return nil, evaluation{}, strings.Join(messages, " "), nil
+}
+
+// listPolicies returns the CertificateRequestPolicies, and the
+// NamespacedCertificateRequestPolicies in the namespace of the request, which
+// are to be filtered by the predicates. If the lister is indexed, only the
+// candidate policies found with the field indexes are returned.
+func (m *mngr) listPolicies(ctx context.Context, cr *cmapi.CertificateRequest) ([]policyapi.CertificateRequestPolicy, []policyapi.NamespacedCertificateRequestPolicy, error) {
+	if m.indexed {
+		return m.listCandidatePolicies(ctx, cr)
+	}
+
+	policyList := new(policyapi.CertificateRequestPolicyList)
+	if err := m.lister.List(ctx, policyList); err != nil {
+		return nil, nil, err
+	}
+
+	namespacedPolicyList := new(policyapi.NamespacedCertificateRequestPolicyList)
+	if err := m.lister.List(ctx, namespacedPolicyList, client.InNamespace(cr.Namespace)); err != nil {
+		return nil, nil, err
+	}
+
+	return policyList.Items, namespacedPolicyList.Items, nil
+}
+
+// policiesExist returns true if any CertificateRequestPolicy exists.
+func (m *mngr) policiesExist(ctx context.Context) (bool, error) {
+	policyList := new(policyapi.CertificateRequestPolicyList)
+	if err := m.lister.List(ctx, policyList, client.Limit(1), client.UnsafeDisableDeepCopy); err != nil {
+		return false, err
+	}
+	return len(policyList.Items) > 0, nil
+}
+
+// policyKind returns the kind of the resource the given policy was built from.
+func policyKind(policy *policyapi.CertificateRequestPolicy) string {
+	if util.IsNamespacedPolicy(policy) {
+		return policyapi.NamespacedCertificateRequestPolicyKind
+	}
+	return policyapi.CertificateRequestPolicyKind
+}
+
+// evaluateAll runs every evaluator against each of the given policies
+// concurrently, with at most m.concurrency policies being evaluated at a time.
+// decides reports whether the evaluation of a policy decides the outcome of
+// the review. Returns the index of the first policy, in the given order, whose
+// evaluation decides the outcome, or -1 if none do, along with the
+// evaluations of the policies. Only the evaluations of the policies before the
+// deciding policy, and of the deciding policy itself, are complete; the
+// evaluation of policies after it is cancelled through their context once the
+// deciding policy is known.
+// If evaluating any policy before the deciding policy errors, the error of
+// the first such policy is returned.
+func (m *mngr) evaluateAll(ctx context.Context, policies []policyapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest,
+	decides func(evaluation) bool) ([]evaluation, int, error) {
+	var (
+		lock        sync.Mutex
+		decided     = len(policies)
+		evaluations = make([]evaluation, len(policies))
+		errs        = make([]error, len(policies))
+		cancels     = make([]context.CancelFunc, len(policies))
+	)
+
+	var group errgroup.Group
+	group.SetLimit(m.concurrency)
+
+	for i := range policies {
+		lock.Lock()
+		if i > decided {
+			lock.Unlock()
+			break
+		}
+		policyCtx, cancel := context.WithCancel(ctx)
+		cancels[i] = cancel
+		lock.Unlock()
+
+		group.Go(func() error {
+			defer cancel()
+
+			// Skip policies after the deciding policy that were queued before it
+			// was known.
+			lock.Lock()
+			skip := i > decided
+			lock.Unlock()
+			if skip {
+				return nil
+			}
+
+			e, err := m.evaluate(policyCtx, &policies[i], cr)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			evaluations[i] = e
+			errs[i] = err
+
+			// Cancel the evaluation of all policies after this one if it decides
+			// the outcome. An error also decides the outcome since it is returned,
+			// unless a policy before this one decides it first.
+			if i < decided && (err != nil || decides(evaluations[i])) {
+				decided = i
+				for _, cancel := range cancels[i+1:] {
+					if cancel != nil {
+						cancel()
+					}
+				}
+			}
+
+			return nil
+		})
+	}
+
+	// Errors are collected per policy, so the group never returns an error.
+	_ = group.Wait()
+
+	for i := 0; i < len(policies) && i <= decided; i++ {
+		if errs[i] != nil {
+			return nil, -1, errs[i]
+		}
+	}
+
+	if decided == len(policies) {
+		return evaluations, -1, nil
+	}
+
+	return evaluations, decided, nil
+}
+
+// evaluate runs every evaluator against the given policy and request. Returns
+// whether any evaluator denied the request, along with the aggregated messages
+// of all evaluators, the longest duration any evaluator asked for the request
+// to be reviewed again after, and whether any evaluator left it pending.
+func (m *mngr) evaluate(ctx context.Context, policy *policyapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) (evaluation, error) {
+	var (
+		evaluatorDenied   bool
+		evaluatorMessages []string
+		requeueAfter      time.Duration
+		pending           bool
+		terminal          bool
+	)
+
+	for _, evaluator := range m.evaluators {
+		response, err := evaluator.Evaluate(ctx, policy, cr)
+		if err != nil {
+			// if a single evaluator errors, then return early without trying
+			// others.
+			return evaluation{}, err
+		}
+
+		if len(response.Message) > 0 {
+			evaluatorMessages = append(evaluatorMessages, response.Message)
+		}
+
+		// evaluatorDenied will be set to true if any evaluator denies. We don't
+		// break early so that we can capture the responses from _all_
+		// evaluators.
+		if response.Result == approver.ResultDenied {
+			evaluatorDenied = true
+			terminal = terminal || response.Terminal
+		} else {
+			requeueAfter = max(requeueAfter, response.RequeueAfter)
+			pending = pending || response.Pending
+		}
+	}
+
+	// A request which is denied is never reviewed again.
+	if evaluatorDenied {
+		requeueAfter, pending = 0, false
+	}
+
+	return evaluation{
+		denied:       evaluatorDenied,
+		message:      strings.Join(evaluatorMessages, ", "),
+		requeueAfter: requeueAfter,
+		pending:      pending,
+		terminal:     terminal,
 	}, nil
 }
@@ -19,8 +19,12 @@ package manager
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path"
+	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -211,6 +215,302 @@ func Test_Review(t *testing.T) {
 			expResponse: manager.ReviewResponse{Result: manager.ResultDenied, Message: "No policy approved this request: [test-policy-a: this is a denied response] [test-policy-b: this is a denied response]"},
 			expErr:      false,
 		},
+		"if deny policy matches the request, return ResultDenied even if allow policy approves": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Name == "test-policy-deny" {
+						return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "spec.allowed.dnsNames: example.com"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-deny"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Effect: policyapi.CertificateRequestPolicyEffectDeny, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-deny"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Effect: policyapi.CertificateRequestPolicyEffectDeny, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+			},
+			expResponse: manager.ReviewResponse{Result: manager.ResultDenied, Message: `Denied by CertificateRequestPolicy: "test-policy-deny": spec.allowed.dnsNames: example.com`},
+			expErr:      false,
+		},
+		"if deny policy does not match the request, return ResultApproved from allow policy": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Name == "test-policy-deny" {
+						return approver.EvaluationResponse{Result: approver.ResultDenied, Message: "this is a denied response"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-deny"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Effect: policyapi.CertificateRequestPolicyEffectDeny, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-deny"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Effect: policyapi.CertificateRequestPolicyEffectDeny, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+			},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "test-policy-a"`},
+			expErr:      false,
+		},
+		"if policy and namespaced policy both approve, return ResultApproved": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Namespace == "test-namespace" {
+						return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "this is a namespaced response"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy-namespaced"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+			}},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "test-policy-a" and NamespacedCertificateRequestPolicy: "test-policy-namespaced"`},
+			expErr:      false,
+		},
+		"if policy approves but namespaced policy denies, return ResultDenied": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Namespace == "test-namespace" {
+						return approver.EvaluationResponse{Result: approver.ResultDenied, Message: "this is a namespaced response"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy-namespaced"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+			}},
+			expResponse: manager.ReviewResponse{Result: manager.ResultDenied, Message: "No NamespacedCertificateRequestPolicy approved this request: [test-policy-namespaced: this is a namespaced response]"},
+			expErr:      false,
+		},
+		"if only namespaced policy is applicable, return ResultUnprocessed": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Namespace == "test-namespace" {
+						return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "this is a namespaced response"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy-namespaced"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+			}},
+			expResponse: manager.ReviewResponse{Result: manager.ResultUnprocessed, Message: "No CertificateRequestPolicies bound or applicable"},
+			expErr:      false,
+		},
+		"if enforced policy approves and audit policy denies, return ResultApproved with audit": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					if policy.Name == "test-policy-audit" {
+						return approver.EvaluationResponse{Result: approver.ResultDenied, Message: "this is a denied response"}, nil
+					}
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-audit"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-audit-deny"},
+							Spec: policyapi.CertificateRequestPolicySpec{
+								Effect:      policyapi.CertificateRequestPolicyEffectDeny,
+								Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit,
+								Selector:    policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+							},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+				Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+			}},
+			expResponse: manager.ReviewResponse{
+				Result:  manager.ResultApproved,
+				Message: `Approved by CertificateRequestPolicy: "test-policy-a"`,
+				Audits: []manager.AuditResponse{
+					{Kind: "CertificateRequestPolicy", Name: "test-policy-audit", Result: manager.ResultDenied, Message: "this is a denied response"},
+					{Kind: "CertificateRequestPolicy", Name: "test-policy-audit-deny", Result: manager.ResultDenied},
+				},
+			},
+			expErr: false,
+		},
+		"if only audit policy is applicable and approves, return ResultUnprocessed with audit": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, _ *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{{
+						ObjectMeta: metav1.ObjectMeta{Name: "test-policy-audit"},
+						Spec:       policyapi.CertificateRequestPolicySpec{Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+					}}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy-audit"},
+				Spec:       policyapi.CertificateRequestPolicySpec{Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+			}},
+			expResponse: manager.ReviewResponse{
+				Result:  manager.ResultUnprocessed,
+				Message: "No CertificateRequestPolicies bound or applicable",
+				Audits: []manager.AuditResponse{
+					{Kind: "CertificateRequestPolicy", Name: "test-policy-audit", Result: manager.ResultApproved},
+				},
+			},
+			expErr: false,
+		},
+		"if two policies approve, return ResultApproved by the policy with the highest priority": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, _ *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-b"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Priority: 10, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-b"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Priority: 10, Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+			},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "test-policy-b"`},
+			expErr:      false,
+		},
+		"if two policies of the same priority approve, return ResultApproved by the policy first by name": {
+			evaluator: func(t *testing.T) approver.Evaluator {
+				return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, _ *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				})
+			},
+			predicate: func(t *testing.T) predicate.Predicate {
+				return func(_ context.Context, _ *cmapi.CertificateRequest, _ []policyapi.CertificateRequestPolicy) ([]policyapi.CertificateRequestPolicy, error) {
+					return []policyapi.CertificateRequestPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-b"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+							Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+						},
+					}, nil
+				}
+			},
+			policies: []policyapi.CertificateRequestPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-b"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-policy-a"},
+					Spec:       policyapi.CertificateRequestPolicySpec{Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}}},
+				},
+			},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "test-policy-a"`},
+			expErr:      false,
+		},
 	}
 
 	for name, test := range tests {
@@ -235,6 +535,8 @@ func Test_Review(t *testing.T) {
 				lister:     env.AdminClient,
 				predicates: []predicate.Predicate{test.predicate(t)},
 				evaluators: []approver.Evaluator{test.evaluator(t)},
+
+				concurrency: 1,
 			}
 
 			response, err := mngr.Review(context.TODO(), &cmapi.CertificateRequest{
@@ -254,3 +556,262 @@ func Test_Review(t *testing.T) {
 		})
 	}
 }
+
+func Test_evaluateAll(t *testing.T) {
+	policies := make([]policyapi.CertificateRequestPolicy, 20)
+	for i := range policies {
+		policies[i].Name = fmt.Sprintf("policy-%02d", i)
+	}
+
+	// approveFrom returns an evaluator which denies policies before the given
+	// index, and approves the rest. Evaluations take a varying amount of time
+	// so that they complete out of order.
+	approveFrom := func(index int) approver.Evaluator {
+		return fake.NewFakeEvaluator().WithEvaluate(func(ctx context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+			i := slices.IndexFunc(policies, func(p policyapi.CertificateRequestPolicy) bool { return p.Name == policy.Name })
+			select {
+			case <-ctx.Done():
+				return approver.EvaluationResponse{}, ctx.Err()
+			case <-time.After(time.Duration(len(policies)-i) * time.Millisecond):
+			}
+			if i < index {
+				return approver.EvaluationResponse{Result: approver.ResultDenied, Message: policy.Name}, nil
+			}
+			return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+		})
+	}
+
+	approves := func(e evaluation) bool { return !e.denied }
+
+	tests := map[string]struct {
+		evaluator   approver.Evaluator
+		expDecided  int
+		expMessages []string
+		expErr      bool
+	}{
+		"if no policy approves, should return no decision with all evaluations": {
+			evaluator:  approveFrom(len(policies)),
+			expDecided: -1,
+			expMessages: func() []string {
+				var messages []string
+				for _, policy := range policies {
+					messages = append(messages, policy.Name)
+				}
+				return messages
+			}(),
+		},
+		"if a later policy approves, should return the first approving policy": {
+			evaluator:   approveFrom(3),
+			expDecided:  3,
+			expMessages: []string{"policy-00", "policy-01", "policy-02", ""},
+		},
+		"if the first policy approves, should cancel the evaluation of all other policies": {
+			evaluator: fake.NewFakeEvaluator().WithEvaluate(func(ctx context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+				if policy.Name == "policy-00" {
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				}
+				<-ctx.Done()
+				return approver.EvaluationResponse{}, ctx.Err()
+			}),
+			expDecided:  0,
+			expMessages: []string{""},
+		},
+		"if a policy before the approving policy errors, should return error": {
+			evaluator: fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+				switch policy.Name {
+				case "policy-00":
+					return approver.EvaluationResponse{Result: approver.ResultDenied}, nil
+				case "policy-01":
+					return approver.EvaluationResponse{}, errors.New("this is an error")
+				default:
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				}
+			}),
+			expErr: true,
+		},
+		"if a policy after the approving policy errors, should return the approving policy": {
+			evaluator: fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+				if policy.Name == "policy-00" {
+					return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+				}
+				return approver.EvaluationResponse{}, errors.New("this is an error")
+			}),
+			expDecided:  0,
+			expMessages: []string{""},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, concurrency := range []int{1, 4, len(policies)} {
+				mngr := &mngr{evaluators: []approver.Evaluator{test.evaluator}, concurrency: concurrency}
+
+				evaluations, decided, err := mngr.evaluateAll(context.TODO(), policies, nil, approves)
+				assert.Equal(t, test.expErr, err != nil, "concurrency %d: %v", concurrency, err)
+				if test.expErr {
+					continue
+				}
+				assert.Equal(t, test.expDecided, decided, "concurrency %d", concurrency)
+
+				var messages []string
+				for _, evaluation := range evaluations[:len(test.expMessages)] {
+					messages = append(messages, evaluation.message)
+				}
+				assert.Equal(t, test.expMessages, messages, "concurrency %d", concurrency)
+			}
+		})
+	}
+}
+
+func Test_evaluateAll_Concurrency(t *testing.T) {
+	const concurrency = 3
+
+	policies := make([]policyapi.CertificateRequestPolicy, 10)
+
+	var inflight, maxInflight atomic.Int32
+	mngr := &mngr{
+		concurrency: concurrency,
+		evaluators: []approver.Evaluator{fake.NewFakeEvaluator().WithEvaluate(func(context.Context, *policyapi.CertificateRequestPolicy, *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+			n := inflight.Add(1)
+			defer inflight.Add(-1)
+			for {
+				current := maxInflight.Load()
+				if n <= current || maxInflight.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return approver.EvaluationResponse{Result: approver.ResultDenied}, nil
+		})},
+	}
+
+	_, decided, err := mngr.evaluateAll(context.TODO(), policies, nil, func(e evaluation) bool { return !e.denied })
+	assert.NoError(t, err)
+	assert.Equal(t, -1, decided)
+	assert.LessOrEqual(t, maxInflight.Load(), int32(concurrency))
+	assert.Greater(t, maxInflight.Load(), int32(1), "expected policies to be evaluated concurrently")
+}
+
+func Test_review_RequeueAfter(t *testing.T) {
+	policy := func(name string, priority int32, namespace string) policyapi.CertificateRequestPolicy {
+		return policyapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       policyapi.CertificateRequestPolicySpec{Priority: priority},
+		}
+	}
+
+	// delay returns an evaluator which asks for the request to be requeued on
+	// the policies with the given names, and denies on the policies with the
+	// names in deny.
+	delay := func(requeueAfter time.Duration, delayed []string, denied ...string) approver.Evaluator {
+		return fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+			if slices.Contains(denied, policy.Name) {
+				return approver.EvaluationResponse{Result: approver.ResultDenied, Message: "denied"}, nil
+			}
+			if slices.Contains(delayed, policy.Name) {
+				return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "limited", RequeueAfter: requeueAfter}, nil
+			}
+			return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+		})
+	}
+
+	// pending is an evaluator which leaves the request pending on policy-a.
+	pending := fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+		if policy.Name == "policy-a" {
+			return approver.EvaluationResponse{Result: approver.ResultNotDenied, Message: "awaiting approval", Pending: true}, nil
+		}
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	})
+
+	// vetoed is an evaluator which denies the request outright on policy-a.
+	vetoed := fake.NewFakeEvaluator().WithEvaluate(func(_ context.Context, policy *policyapi.CertificateRequestPolicy, _ *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+		if policy.Name == "policy-a" {
+			return approver.EvaluationResponse{Result: approver.ResultDenied, Message: "vetoed", Terminal: true}, nil
+		}
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	})
+
+	tests := map[string]struct {
+		evaluators  []approver.Evaluator
+		policies    []policyapi.CertificateRequestPolicy
+		expResponse manager.ReviewResponse
+	}{
+		"if the approving policy asks for a requeue, return ResultUnprocessed with RequeueAfter": {
+			evaluators: []approver.Evaluator{delay(time.Minute, []string{"policy-a"})},
+			policies:   []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{
+				Result:       manager.ResultUnprocessed,
+				Message:      `Delayed by CertificateRequestPolicy: "policy-a": limited`,
+				RequeueAfter: time.Minute,
+			},
+		},
+		"if a policy which is not the approving policy asks for a requeue, return ResultApproved": {
+			evaluators:  []approver.Evaluator{delay(time.Minute, []string{"policy-b"})},
+			policies:    []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "policy-a"`},
+		},
+		"if the policy asking for a requeue is denied by another evaluator, the request is not delayed": {
+			evaluators:  []approver.Evaluator{delay(time.Minute, []string{"policy-a"}), delay(0, nil, "policy-a")},
+			policies:    []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "policy-b"`},
+		},
+		"if evaluators ask for different requeues, the longest is used": {
+			evaluators: []approver.Evaluator{delay(time.Minute, []string{"policy-a"}), delay(time.Hour, []string{"policy-a"})},
+			policies:   []policyapi.CertificateRequestPolicy{policy("policy-a", 0, "")},
+			expResponse: manager.ReviewResponse{
+				Result:       manager.ResultUnprocessed,
+				Message:      `Delayed by CertificateRequestPolicy: "policy-a": limited, limited`,
+				RequeueAfter: time.Hour,
+			},
+		},
+		"if the approving NamespacedCertificateRequestPolicy asks for a requeue, return ResultUnprocessed with RequeueAfter": {
+			evaluators: []approver.Evaluator{delay(time.Minute, []string{"namespaced-policy"})},
+			policies:   []policyapi.CertificateRequestPolicy{policy("policy-a", 0, ""), policy("namespaced-policy", 0, "test-namespace")},
+			expResponse: manager.ReviewResponse{
+				Result:       manager.ResultUnprocessed,
+				Message:      `Delayed by NamespacedCertificateRequestPolicy: "namespaced-policy": limited`,
+				RequeueAfter: time.Minute,
+			},
+		},
+		"if the approving policy leaves the request pending, return ResultUnprocessed with Pending": {
+			evaluators: []approver.Evaluator{pending},
+			policies:   []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{
+				Result:  manager.ResultUnprocessed,
+				Message: `Pending approval for CertificateRequestPolicy: "policy-a": awaiting approval`,
+				Pending: true,
+			},
+		},
+		"if the policy leaving the request pending is denied by another evaluator, the request is not pending": {
+			evaluators:  []approver.Evaluator{pending, delay(0, nil, "policy-a")},
+			policies:    []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "policy-b"`},
+		},
+		"if a policy denies the request outright, return ResultDenied even though another policy would approve it": {
+			evaluators:  []approver.Evaluator{vetoed},
+			policies:    []policyapi.CertificateRequestPolicy{policy("policy-a", 10, ""), policy("policy-b", 0, "")},
+			expResponse: manager.ReviewResponse{Result: manager.ResultDenied, Message: `Denied by CertificateRequestPolicy: "policy-a": vetoed`},
+		},
+		"if a higher priority policy approves the request, a lower priority policy denying it outright is not considered": {
+			evaluators:  []approver.Evaluator{vetoed},
+			policies:    []policyapi.CertificateRequestPolicy{policy("policy-a", 0, ""), policy("policy-b", 10, "")},
+			expResponse: manager.ReviewResponse{Result: manager.ResultApproved, Message: `Approved by CertificateRequestPolicy: "policy-b"`},
+		},
+		"if a NamespacedCertificateRequestPolicy denies the request outright, return ResultDenied even though another would approve it": {
+			evaluators: []approver.Evaluator{vetoed},
+			policies: []policyapi.CertificateRequestPolicy{
+				policy("policy-c", 0, ""), policy("policy-a", 10, "tenant-a"), policy("policy-b", 0, "tenant-a"),
+			},
+			expResponse: manager.ReviewResponse{Result: manager.ResultDenied, Message: `Denied by NamespacedCertificateRequestPolicy: "policy-a": vetoed`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &mngr{evaluators: test.evaluators, concurrency: 1}
+			response, err := m.review(context.TODO(), test.policies, &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-request"}})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
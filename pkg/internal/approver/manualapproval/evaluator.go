@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualapproval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
+)
+
+func (m *manualApproval) Evaluate(ctx context.Context, policy *policyapi.CertificateRequestPolicy, request *cmapi.CertificateRequest) (approver.EvaluationResponse, error) {
+	// If no manual approval defined, or the policy denies requests, exit early.
+	if policy.Spec.ManualApproval == nil || policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	if m.lister == nil {
+		return approver.EvaluationResponse{}, errors.New("manualapproval approver has not been prepared")
+	}
+
+	var (
+		manual  = policy.Spec.ManualApproval
+		fldPath = field.NewPath("spec", "manualApproval")
+	)
+
+	var votes policyapi.CertificateRequestVoteList
+	if err := m.lister.List(ctx, &votes, client.InNamespace(request.Namespace), client.MatchingFields{IndexCertificateRequestName: request.Name}); err != nil {
+		return approver.EvaluationResponse{}, fmt.Errorf("failed to list certificaterequestvotes: %w", err)
+	}
+
+	approvedBy := sets.New[string]()
+	deniedBy := sets.New[string]()
+	for _, vote := range votes.Items {
+		// Votes for another request of the same name, such as one which has
+		// since been deleted, are ignored.
+		if vote.Spec.CertificateRequestUID != request.UID {
+			continue
+		}
+		// The requester may not vote on their own request, and votes by users
+		// who are not approvers are ignored.
+		if vote.Spec.Username == request.Spec.Username || !isApprover(manual.Approvers, vote.Spec) {
+			continue
+		}
+
+		switch vote.Spec.Decision {
+		case policyapi.CertificateRequestVoteDecisionApprove:
+			approvedBy.Insert(vote.Spec.Username)
+		case policyapi.CertificateRequestVoteDecisionDeny:
+			deniedBy.Insert(vote.Spec.Username)
+		}
+	}
+
+	if deniedBy.Len() > 0 {
+		var el field.ErrorList
+		for _, username := range sets.List(deniedBy) {
+			el = append(el, field.Forbidden(fldPath.Child("approvers"), fmt.Sprintf("denied by approver %q", username)))
+		}
+		// A deny vote denies the request outright, rather than leaving it to
+		// be approved by another policy.
+		return approver.EvaluationResponse{Result: approver.ResultDenied, Message: el.ToAggregate().Error(), Terminal: true}, nil
+	}
+
+	// Approvers are deduplicated by username, so that one approver may not
+	// approve a request more than once.
+	if approvedBy.Len() >= manual.RequiredApprovals {
+		return approver.EvaluationResponse{Result: approver.ResultNotDenied}, nil
+	}
+
+	return approver.EvaluationResponse{
+		Result: approver.ResultNotDenied,
+		Message: field.ErrorList{
+			field.Forbidden(fldPath.Child("requiredApprovals"), fmt.Sprintf("approved by %d of %d required approvers", approvedBy.Len(), manual.RequiredApprovals)),
+		}.ToAggregate().Error(),
+		Pending: true,
+	}, nil
+}
+
+// isApprover returns true if the user that cast the vote is one of the
+// approvers.
+func isApprover(approvers policyapi.CertificateRequestPolicyManualApprovalApprovers, vote policyapi.CertificateRequestVoteSpec) bool {
+	return util.WildcardContains(approvers.Users, vote.Username) ||
+		slices.ContainsFunc(vote.Groups, func(group string) bool {
+			return util.WildcardContains(approvers.Groups, group)
+		})
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualapproval
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// builderIndexer registers field indexes with a fake client builder.
+type builderIndexer struct {
+	builder *fakeclient.ClientBuilder
+}
+
+func (b builderIndexer) IndexField(_ context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, fn)
+	return nil
+}
+
+func Test_Evaluate(t *testing.T) {
+	fldPath := field.NewPath("spec", "manualApproval")
+
+	// uid returns the UID of the request with the given namespace and name.
+	uid := func(namespace, name string) types.UID {
+		return types.UID(namespace + "/" + name)
+	}
+	vote := func(namespace, name, request string, requestUID types.UID, decision policyapi.CertificateRequestVoteDecision, username string, groups ...string) client.Object {
+		return &policyapi.CertificateRequestVote{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: policyapi.CertificateRequestVoteSpec{
+				CertificateRequestName: request,
+				CertificateRequestUID:  requestUID,
+				Decision:               decision,
+				Username:               username,
+				Groups:                 groups,
+			},
+		}
+	}
+	approve, deny := policyapi.CertificateRequestVoteDecisionApprove, policyapi.CertificateRequestVoteDecisionDeny
+
+	objects := []client.Object{
+		// request-a is approved by two approvers, one of them twice.
+		vote("tenant-a", "vote-1", "request-a", uid("tenant-a", "request-a"), approve, "alice", "security"),
+		vote("tenant-a", "vote-2", "request-a", uid("tenant-a", "request-a"), approve, "alice", "security"),
+		vote("tenant-a", "vote-3", "request-a", uid("tenant-a", "request-a"), approve, "bob"),
+		// request-b is approved by the requester, a user who is not an
+		// approver, and an approver of a previous request of the same name.
+		vote("tenant-a", "vote-4", "request-b", uid("tenant-a", "request-b"), approve, "mallory", "security"),
+		vote("tenant-a", "vote-5", "request-b", uid("tenant-a", "request-b"), approve, "eve", "developers"),
+		vote("tenant-a", "vote-6", "request-b", "previous-request-b", approve, "alice", "security"),
+		// request-c is approved by one approver and denied by another.
+		vote("tenant-a", "vote-7", "request-c", uid("tenant-a", "request-c"), approve, "alice", "security"),
+		vote("tenant-a", "vote-8", "request-c", uid("tenant-a", "request-c"), deny, "bob"),
+		// request-a in another namespace is approved by one approver.
+		vote("tenant-b", "vote-9", "request-a", uid("tenant-b", "request-a"), approve, "alice", "security"),
+	}
+
+	builder := fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(objects...)
+	require.NoError(t, RegisterIndexes(context.TODO(), builderIndexer{builder: builder}))
+	m := &manualApproval{lister: builder.Build()}
+
+	manual := &policyapi.CertificateRequestPolicyManualApproval{
+		RequiredApprovals: 2,
+		Approvers: policyapi.CertificateRequestPolicyManualApprovalApprovers{
+			Users:  []string{"bob"},
+			Groups: []string{"secur*"},
+		},
+	}
+	request := func(namespace, name string) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid(namespace, name)},
+			Spec:       cmapi.CertificateRequestSpec{Username: "mallory"},
+		}
+	}
+	pending := func(approvals int) approver.EvaluationResponse {
+		return approver.EvaluationResponse{
+			Result: approver.ResultNotDenied,
+			Message: field.ErrorList{
+				field.Forbidden(fldPath.Child("requiredApprovals"), fmt.Sprintf("approved by %d of 2 required approvers", approvals)),
+			}.ToAggregate().Error(),
+			Pending: true,
+		}
+	}
+
+	tests := map[string]struct {
+		effect      policyapi.CertificateRequestPolicyEffect
+		manual      *policyapi.CertificateRequestPolicyManualApproval
+		request     *cmapi.CertificateRequest
+		expResponse approver.EvaluationResponse
+	}{
+		"if no manual approval defined, return NotDenied": {
+			request:     request("tenant-a", "request-d"),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if the policy is a Deny policy, return NotDenied": {
+			effect:      policyapi.CertificateRequestPolicyEffectDeny,
+			manual:      manual,
+			request:     request("tenant-a", "request-d"),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if there are no votes, return pending": {
+			manual:      manual,
+			request:     request("tenant-a", "request-d"),
+			expResponse: pending(0),
+		},
+		"if enough distinct approvers approved, return NotDenied": {
+			manual:      manual,
+			request:     request("tenant-a", "request-a"),
+			expResponse: approver.EvaluationResponse{Result: approver.ResultNotDenied},
+		},
+		"if votes are by the requester, non-approvers or for a previous request, they are ignored": {
+			manual:      manual,
+			request:     request("tenant-a", "request-b"),
+			expResponse: pending(0),
+		},
+		"if an approver denied, return terminal Denied": {
+			manual:  manual,
+			request: request("tenant-a", "request-c"),
+			expResponse: approver.EvaluationResponse{
+				Result: approver.ResultDenied,
+				Message: field.ErrorList{
+					field.Forbidden(fldPath.Child("approvers"), `denied by approver "bob"`),
+				}.ToAggregate().Error(),
+				Terminal: true,
+			},
+		},
+		"if votes are in another namespace, they are ignored": {
+			manual:      manual,
+			request:     request("tenant-b", "request-a"),
+			expResponse: pending(1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Effect:         test.effect,
+				ManualApproval: test.manual,
+			}}
+			response, err := m.Evaluate(context.TODO(), policy, test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualapproval
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/registry"
+)
+
+// IndexCertificateRequestName indexes CertificateRequestVotes by the name of
+// the CertificateRequest they vote on.
+const IndexCertificateRequestName = "spec.certificateRequestName"
+
+// Load the manualapproval approver.
+func init() {
+	registry.Shared.Store(Approver())
+}
+
+// Approver returns an instance of the manualapproval approver.
+func Approver() approver.Interface {
+	return new(manualApproval)
+}
+
+// manualApproval is an approver-policy Approver that is responsible for
+// leaving requests pending until they have been approved by enough approvers
+// of CertificateRequestPolicies, using CertificateRequestVotes in the cache of
+// the manager.
+type manualApproval struct {
+	lister client.Reader
+}
+
+// Name of Approver is "manualapproval"
+func (m *manualApproval) Name() string {
+	return "manualapproval"
+}
+
+// RegisterFlags is a no-op, manualapproval doesn't need any flags.
+func (m *manualApproval) RegisterFlags(_ *pflag.FlagSet) {}
+
+// Prepare registers the index of CertificateRequestVotes with the cache of the
+// manager, which is used to look up the votes on a request.
+func (m *manualApproval) Prepare(ctx context.Context, _ logr.Logger, mgr manager.Manager) error {
+	if err := RegisterIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+	m.lister = mgr.GetCache()
+	return nil
+}
+
+// Ready always returns ready, manualapproval doesn't have any dependencies to
+// block readiness.
+func (m *manualApproval) Ready(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
+	return approver.ReconcilerReadyResponse{Ready: true}, nil
+}
+
+// manualapproval never needs to manually enqueue policies. Requests are
+// reviewed again when votes are cast on them by the certificaterequests
+// controller.
+func (m *manualApproval) EnqueueChan() <-chan string {
+	return nil
+}
+
+// RegisterIndexes registers the index of CertificateRequestVotes by the name
+// of the CertificateRequest they vote on. Must be called with the field
+// indexer of the cache that is used to look up votes, before the cache is
+// started.
+func RegisterIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &policyapi.CertificateRequestVote{}, IndexCertificateRequestName, func(obj client.Object) []string {
+		return []string{obj.(*policyapi.CertificateRequestVote).Spec.CertificateRequestName}
+	}); err != nil {
+		return fmt.Errorf("failed to index certificaterequestvotes by %s: %w", IndexCertificateRequestName, err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualapproval
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+// Validate validates that the processed CertificateRequestPolicy has a valid
+// manual approval defined.
+func (m *manualApproval) Validate(_ context.Context, policy *policyapi.CertificateRequestPolicy) (approver.WebhookValidationResponse, error) {
+	// If no manual approval is defined we can exit early
+	if policy.Spec.ManualApproval == nil {
+		return approver.WebhookValidationResponse{Allowed: true}, nil
+	}
+
+	var (
+		el      field.ErrorList
+		manual  = policy.Spec.ManualApproval
+		fldPath = field.NewPath("spec", "manualApproval")
+	)
+
+	if policy.Spec.Effect == policyapi.CertificateRequestPolicyEffectDeny {
+		el = append(el, field.Forbidden(fldPath, "manualApproval cannot be defined on Deny policies"))
+	}
+
+	if manual.RequiredApprovals < 1 {
+		el = append(el, field.Invalid(fldPath.Child("requiredApprovals"), manual.RequiredApprovals, "must be 1 or larger"))
+	}
+
+	if len(manual.Approvers.Users) == 0 && len(manual.Approvers.Groups) == 0 {
+		el = append(el, field.Required(fldPath.Child("approvers"), "one of users or groups must be defined"))
+	}
+
+	return approver.WebhookValidationResponse{
+		Allowed: len(el) == 0,
+		Errors:  el,
+	}, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualapproval
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/approver"
+)
+
+func Test_Validate(t *testing.T) {
+	fldPath := field.NewPath("spec", "manualApproval")
+
+	tests := map[string]struct {
+		effect      policyapi.CertificateRequestPolicyEffect
+		manual      *policyapi.CertificateRequestPolicyManualApproval
+		expResponse approver.WebhookValidationResponse
+	}{
+		"if no manual approval defined, return allowed": {
+			expResponse: approver.WebhookValidationResponse{Allowed: true},
+		},
+		"if a valid manual approval defined, return allowed": {
+			manual: &policyapi.CertificateRequestPolicyManualApproval{
+				RequiredApprovals: 2,
+				Approvers:         policyapi.CertificateRequestPolicyManualApprovalApprovers{Groups: []string{"security"}},
+			},
+			expResponse: approver.WebhookValidationResponse{Allowed: true},
+		},
+		"if manual approval defined on a Deny policy, return not allowed": {
+			effect: policyapi.CertificateRequestPolicyEffectDeny,
+			manual: &policyapi.CertificateRequestPolicyManualApproval{
+				RequiredApprovals: 1,
+				Approvers:         policyapi.CertificateRequestPolicyManualApprovalApprovers{Users: []string{"alice"}},
+			},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Forbidden(fldPath, "manualApproval cannot be defined on Deny policies"),
+				},
+			},
+		},
+		"if no approvals are required and no approvers are defined, return not allowed": {
+			manual: &policyapi.CertificateRequestPolicyManualApproval{},
+			expResponse: approver.WebhookValidationResponse{
+				Allowed: false,
+				Errors: field.ErrorList{
+					field.Invalid(fldPath.Child("requiredApprovals"), 0, "must be 1 or larger"),
+					field.Required(fldPath.Child("approvers"), "one of users or groups must be defined"),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy := &policyapi.CertificateRequestPolicy{Spec: policyapi.CertificateRequestPolicySpec{
+				Effect:         test.effect,
+				ManualApproval: test.manual,
+			}}
+			response, err := new(manualApproval).Validate(context.TODO(), policy)
+			require.NoError(t, err)
+			assert.Equal(t, test.expResponse, response)
+		})
+	}
+}
@@ -30,10 +30,24 @@ type Cache interface {
 	//
 	// The supplied CEL expression must output a bool.
 	Get(expr string) (Validator, error)
+
+	// GetRequest returns a compiled request validator for the supplied CEL
+	// expression. Any compilation errors will be returned to the caller.
+	//
+	// The supplied CEL expression must output a bool.
+	GetRequest(expr string) (RequestValidator, error)
+
+	// GetSelector returns a compiled selector validator for the supplied CEL
+	// expression. Any compilation errors will be returned to the caller.
+	//
+	// The supplied CEL expression must output a bool.
+	GetSelector(expr string) (SelectorValidator, error)
 }
 
 type cache struct {
-	m sync.Map
+	m         sync.Map
+	requests  sync.Map
+	selectors sync.Map
 }
 
 type cacheEntry struct {
@@ -41,6 +55,16 @@ type cacheEntry struct {
 	err       error
 }
 
+type requestCacheEntry struct {
+	validator *requestValidator
+	err       error
+}
+
+type selectorCacheEntry struct {
+	validator *selectorValidator
+	err       error
+}
+
 func (c *cache) Get(expr string) (Validator, error) {
 	// First check if cache contains validator for expression
 	o, ok := c.m.Load(expr)
@@ -63,6 +87,42 @@ func (c *cache) Get(expr string) (Validator, error) {
 	return ce.validator, ce.err
 }
 
+func (c *cache) GetRequest(expr string) (RequestValidator, error) {
+	// Request and selector validators are cached separately to validators,
+	// since the same expression compiles differently with other variables.
+	o, ok := c.requests.Load(expr)
+	if ok {
+		ce := o.(*requestCacheEntry)
+		return ce.validator, ce.err
+	}
+
+	v := &requestValidator{expression: expr}
+	err := v.compile()
+	if err != nil {
+		v = nil
+	}
+	o, _ = c.requests.LoadOrStore(expr, &requestCacheEntry{validator: v, err: err})
+	ce := o.(*requestCacheEntry)
+	return ce.validator, ce.err
+}
+
+func (c *cache) GetSelector(expr string) (SelectorValidator, error) {
+	o, ok := c.selectors.Load(expr)
+	if ok {
+		ce := o.(*selectorCacheEntry)
+		return ce.validator, ce.err
+	}
+
+	v := &selectorValidator{expression: expr}
+	err := v.compile()
+	if err != nil {
+		v = nil
+	}
+	o, _ = c.selectors.LoadOrStore(expr, &selectorCacheEntry{validator: v, err: err})
+	ce := o.(*selectorCacheEntry)
+	return ce.validator, ce.err
+}
+
 // NewCache is a constructor for cache of compiled CEL expression validators.
 func NewCache() Cache {
 	return &cache{}
@@ -54,3 +54,33 @@ func Test_Cache_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_Cache_GetRequest(t *testing.T) {
+	c := NewCache()
+
+	tests := map[string]struct {
+		expr    string
+		wantErr bool
+	}{
+		"valid-expression":  {expr: "cr.csr.subject.commonName in cr.csr.dnsNames"},
+		"self-not-declared": {expr: "self.endsWith(cr.namespace + '.svc')", wantErr: true},
+		"must-return-bool":  {expr: "cr.name", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetRequest(test.expr)
+
+			if test.wantErr {
+				assert.Error(t, err)
+				// Cache should return same error for same expression
+				_, sameErr := c.GetRequest(test.expr)
+				assert.Same(t, err, sameErr)
+			} else {
+				assert.NoError(t, err)
+				// Cache should return same validator for same expression
+				same, _ := c.GetRequest(test.expr)
+				assert.Same(t, got, same)
+			}
+		})
+	}
+}
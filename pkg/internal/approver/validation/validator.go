@@ -17,18 +17,25 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"reflect"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
-	varSelf    = "self"
-	varRequest = "cr"
+	varSelf            = "self"
+	varRequest         = "cr"
+	varNamespaceLabels = "namespaceLabels"
 )
 
 // Validator knows how to validate CSR attribute values in CertificateRequests
@@ -36,13 +43,42 @@ const (
 // Validator is stateless, thread-safe, and cacheable.
 type Validator interface {
 	// Validate validates the supplied value against the Validator CEL
-	// expression in the context of the request.
+	// expression in the context of the request, as built by
+	// NewCertificateRequest.
 	// Returns 'true' if the value is valid (passes validation).
 	// Returned errors should be considered as internal/technical errors,
 	// and should NOT be returned unprocessed to end-users of the API.
 	// CEL program errors are usually not very human-readable and require
 	// knowledge of how CEL works and is used.
-	Validate(value string, request cmapi.CertificateRequest) (bool, error)
+	Validate(value string, request *CertificateRequest) (bool, error)
+}
+
+// RequestValidator knows how to validate whole CertificateRequests against CEL
+// expressions declared in the top-level validations of a
+// CertificateRequestPolicy. Only the `cr` variable is available to these
+// expressions.
+// RequestValidator is stateless, thread-safe, and cacheable.
+type RequestValidator interface {
+	// Validate validates the request, as built by NewCertificateRequest,
+	// against the RequestValidator CEL expression.
+	// Returns 'true' if the request is valid (passes validation).
+	// Returned errors should be considered as internal/technical errors,
+	// and should NOT be returned unprocessed to end-users of the API.
+	Validate(request *CertificateRequest) (bool, error)
+}
+
+// SelectorValidator knows how to match CertificateRequests against the CEL
+// expression declared in the selector of a CertificateRequestPolicy. The `cr`
+// and `namespaceLabels` variables are available to these expressions.
+// SelectorValidator is stateless, thread-safe, and cacheable.
+type SelectorValidator interface {
+	// Validate validates the request, as built by NewCertificateRequest, and
+	// the labels of the namespace it was created in, against the
+	// SelectorValidator CEL expression.
+	// Returns 'true' if the request matches the expression.
+	// Returned errors should be considered as internal/technical errors,
+	// and should NOT be returned unprocessed to end-users of the API.
+	Validate(request *CertificateRequest, namespaceLabels map[string]string) (bool, error)
 }
 
 type validator struct {
@@ -56,49 +92,197 @@ func (v *validator) compile() error {
 		return nil
 	}
 
-	env, err := cel.NewEnv(
+	var err error
+	v.program, err = compile(v.expression, cel.Variable(varSelf, cel.StringType))
+	return err
+}
+
+func (v *validator) Validate(value string, request *CertificateRequest) (bool, error) {
+	if v.program == nil {
+		return false, errors.New("must compile first")
+	}
+
+	return eval(v.program, map[string]interface{}{
+		varSelf:    value,
+		varRequest: request,
+	})
+}
+
+type requestValidator struct {
+	expression string
+	program    cel.Program
+}
+
+func (v *requestValidator) compile() error {
+	if v.program != nil {
+		// Already compiled
+		return nil
+	}
+
+	var err error
+	v.program, err = compile(v.expression)
+	return err
+}
+
+func (v *requestValidator) Validate(request *CertificateRequest) (bool, error) {
+	if v.program == nil {
+		return false, errors.New("must compile first")
+	}
+
+	return eval(v.program, map[string]interface{}{
+		varRequest: request,
+	})
+}
+
+type selectorValidator struct {
+	expression string
+	program    cel.Program
+}
+
+func (v *selectorValidator) compile() error {
+	if v.program != nil {
+		// Already compiled
+		return nil
+	}
+
+	var err error
+	v.program, err = compile(v.expression, cel.Variable(varNamespaceLabels, cel.MapType(cel.StringType, cel.StringType)))
+	return err
+}
+
+func (v *selectorValidator) Validate(request *CertificateRequest, namespaceLabels map[string]string) (bool, error) {
+	if v.program == nil {
+		return false, errors.New("must compile first")
+	}
+
+	if namespaceLabels == nil {
+		namespaceLabels = map[string]string{}
+	}
+
+	return eval(v.program, map[string]interface{}{
+		varRequest:         request,
+		varNamespaceLabels: namespaceLabels,
+	})
+}
+
+// compile compiles the given CEL expression into a program which outputs a
+// bool. The `cr` variable is always declared, along with any given variables.
+func compile(expression string, vars ...cel.EnvOption) (cel.Program, error) {
+	opts := append([]cel.EnvOption{
 		cel.Types(&CertificateRequest{}),
-		cel.Variable(varSelf, cel.StringType),
 		cel.Variable(varRequest, cel.ObjectType("cm.io.policy.pkg.internal.approver.validation.CertificateRequest")),
 		ext.Strings(),
 		ServiceAccountLib(),
-	)
+	}, vars...)
 
+	env, err := cel.NewEnv(opts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	ast, iss := env.Compile(v.expression)
+	ast, iss := env.Compile(expression)
 	if iss.Err() != nil {
-		return iss.Err()
+		return nil, iss.Err()
 	}
 	if !reflect.DeepEqual(ast.OutputType(), cel.BoolType) {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"got %v, wanted %v result type", ast.OutputType(), cel.BoolType)
 	}
 
-	v.program, err = env.Program(ast)
-	return err
+	return env.Program(ast)
 }
 
-func (v *validator) Validate(value string, request cmapi.CertificateRequest) (bool, error) {
-	if v.program == nil {
-		return false, errors.New("must compile first")
+func eval(program cel.Program, vars map[string]interface{}) (bool, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, err
 	}
 
-	vars := map[string]interface{}{
-		varSelf: value,
-		varRequest: &CertificateRequest{
-			Name:      request.GetName(),
-			Namespace: request.GetNamespace(),
-			Username:  request.Spec.Username,
+	return out.Value().(bool), nil
+}
+
+// NewCertificateRequest builds the CEL representation of the given
+// CertificateRequest, which is passed to validators. The CSR is only decoded
+// if the request contains one. The result should be built once per request
+// and shared between every validator evaluated against it.
+func NewCertificateRequest(request *cmapi.CertificateRequest) (*CertificateRequest, error) {
+	cr := &CertificateRequest{
+		Name:      request.GetName(),
+		Namespace: request.GetNamespace(),
+		Username:  request.Spec.Username,
+		Groups:    request.Spec.Groups,
+		Uid:       request.Spec.UID,
+		IssuerRef: &IssuerRef{
+			Name:  request.Spec.IssuerRef.Name,
+			Kind:  request.Spec.IssuerRef.Kind,
+			Group: request.Spec.IssuerRef.Group,
 		},
+		IsCA:        request.Spec.IsCA,
+		Annotations: request.GetAnnotations(),
+		Labels:      request.GetLabels(),
 	}
 
-	out, _, err := v.program.Eval(vars)
-	if err != nil {
-		return false, err
+	if len(request.Spec.Extra) > 0 {
+		cr.Extra = make(map[string]*StringList, len(request.Spec.Extra))
+		for k, v := range request.Spec.Extra {
+			cr.Extra[k] = &StringList{Values: v}
+		}
 	}
 
-	return out.Value().(bool), nil
+	if request.Spec.Duration != nil {
+		cr.Duration = durationpb.New(request.Spec.Duration.Duration)
+	}
+
+	for _, usage := range request.Spec.Usages {
+		cr.Usages = append(cr.Usages, string(usage))
+	}
+
+	if len(request.Spec.Request) > 0 {
+		csr, err := utilpki.DecodeX509CertificateRequestBytes(request.Spec.Request)
+		if err != nil {
+			return nil, err
+		}
+		cr.Csr = celCertificateSigningRequest(csr)
+	}
+
+	return cr, nil
+}
+
+// celCertificateSigningRequest builds the CEL representation of the given
+// decoded x509 certificate signing request.
+func celCertificateSigningRequest(csr *x509.CertificateRequest) *CertificateSigningRequest {
+	c := &CertificateSigningRequest{
+		Subject: &Subject{
+			CommonName:          csr.Subject.CommonName,
+			Organizations:       csr.Subject.Organization,
+			Countries:           csr.Subject.Country,
+			OrganizationalUnits: csr.Subject.OrganizationalUnit,
+			Localities:          csr.Subject.Locality,
+			Provinces:           csr.Subject.Province,
+			StreetAddresses:     csr.Subject.StreetAddress,
+			PostalCodes:         csr.Subject.PostalCode,
+			SerialNumber:        csr.Subject.SerialNumber,
+		},
+		DnsNames:           csr.DNSNames,
+		EmailAddresses:     csr.EmailAddresses,
+		PublicKeyAlgorithm: csr.PublicKeyAlgorithm.String(),
+	}
+
+	for _, ip := range csr.IPAddresses {
+		c.IpAddresses = append(c.IpAddresses, ip.String())
+	}
+	for _, uri := range csr.URIs {
+		c.Uris = append(c.Uris, uri.String())
+	}
+
+	switch pub := csr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		c.PublicKeySize = int32(pub.N.BitLen()) // #nosec G115 -- key sizes fit in an int32
+	case *ecdsa.PublicKey:
+		c.PublicKeySize = int32(pub.Curve.Params().BitSize) // #nosec G115 -- key sizes fit in an int32
+	case ed25519.PublicKey:
+		c.PublicKeySize = ed25519.PublicKeySize * 8
+	}
+
+	return c
 }
@@ -17,10 +17,16 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/x509"
+	"net"
 	"testing"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_Validator_Compile(t *testing.T) {
@@ -42,6 +48,15 @@ func Test_Validator_Compile(t *testing.T) {
 		{name: "check-serviceaccount-getname", expr: "self.startsWith(serviceAccount(cr.username).getName())", wantErr: false},
 		{name: "check-serviceaccount-getnamespace", expr: "self.startsWith(serviceAccount(cr.username).getNamespace())", wantErr: false},
 		{name: "check-serviceaccount-isSA", expr: "isServiceAccount(cr.username)", wantErr: false},
+		{name: "check-groups-property", expr: "'system:masters' in cr.groups", wantErr: false},
+		{name: "check-extra-property", expr: "cr.extra['scopes'].values.exists(v, v == 'foo')", wantErr: false},
+		{name: "check-issuerref-property", expr: "cr.issuerRef.kind == 'ClusterIssuer'", wantErr: false},
+		{name: "check-duration-property", expr: "cr.duration <= duration('24h')", wantErr: false},
+		{name: "check-isca-property", expr: "!cr.isCA", wantErr: false},
+		{name: "check-usages-property", expr: "'server auth' in cr.usages", wantErr: false},
+		{name: "check-annotations-labels-property", expr: "cr.annotations['foo'] == cr.labels['bar']", wantErr: false},
+		{name: "check-csr-property", expr: "cr.csr.subject.commonName in cr.csr.dnsNames && cr.csr.publicKeySize >= 256", wantErr: false},
+		{name: "err-invalid-csr-property", expr: "cr.csr.foo == 'bar'", wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -78,7 +93,10 @@ func Test_Validator_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := v.Validate(tt.args.val, tt.args.cr)
+			cr, err := NewCertificateRequest(&tt.args.cr)
+			assert.NoError(t, err)
+
+			got, err := v.Validate(tt.args.val, cr)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -120,7 +138,10 @@ func Test_Validator_Validate_ServiceAccount(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := v.Validate(tt.args.val, tt.args.cr)
+			cr, err := NewCertificateRequest(&tt.args.cr)
+			assert.NoError(t, err)
+
+			got, err := v.Validate(tt.args.val, cr)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -140,3 +161,83 @@ func newCertificateRequestWithUsername(username string) cmapi.CertificateRequest
 	}
 	return request
 }
+
+func Test_Validator_Validate_Request(t *testing.T) {
+	csr, _, err := gen.CSR(x509.ECDSA,
+		gen.SetCSRCommonName("foo-ns.example.com"),
+		gen.SetCSRDNSNames("foo-ns.example.com", "bar.example.com"),
+		gen.SetCSRIPAddresses(net.ParseIP("10.0.0.1")),
+		gen.SetCSREmails([]string{"foo@example.com"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-request",
+			Namespace:   "foo-ns",
+			Annotations: map[string]string{"foo": "bar"},
+			Labels:      map[string]string{"team": "a"},
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Username:  "user-1",
+			Groups:    []string{"group-1", "group-2"},
+			UID:       "abc",
+			Extra:     map[string][]string{"scopes": {"foo", "bar"}},
+			IssuerRef: cmmeta.ObjectReference{Name: "my-issuer", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+			Duration:  &metav1.Duration{Duration: time.Hour},
+			IsCA:      true,
+			Usages:    []cmapi.KeyUsage{cmapi.UsageServerAuth, cmapi.UsageDigitalSignature},
+			Request:   csr,
+		},
+	}
+
+	tests := map[string]struct {
+		expr    string
+		request cmapi.CertificateRequest
+		want    bool
+		wantErr bool
+	}{
+		"groups":                 {expr: "'group-2' in cr.groups && !('group-3' in cr.groups)", request: request, want: true},
+		"uid":                    {expr: "cr.uid == 'abc'", request: request, want: true},
+		"extra":                  {expr: "'bar' in cr.extra['scopes'].values", request: request, want: true},
+		"issuerRef":              {expr: "cr.issuerRef.name == 'my-issuer' && cr.issuerRef.kind == 'ClusterIssuer' && cr.issuerRef.group == 'cert-manager.io'", request: request, want: true},
+		"duration":               {expr: "cr.duration == duration('1h')", request: request, want: true},
+		"duration-not-set":       {expr: "!has(cr.duration)", request: newCertificateRequest("foo-ns"), want: true},
+		"isCA":                   {expr: "cr.isCA", request: request, want: true},
+		"usages":                 {expr: "cr.usages == ['server auth', 'digital signature']", request: request, want: true},
+		"annotations and labels": {expr: "cr.annotations['foo'] == 'bar' && cr.labels['team'] == 'a'", request: request, want: true},
+		"csr subject":            {expr: "cr.csr.subject.commonName.startsWith(cr.namespace + '.')", request: request, want: true},
+		"csr SANs":               {expr: "cr.csr.dnsNames.all(d, d.endsWith('.example.com')) && cr.csr.ipAddresses == ['10.0.0.1'] && cr.csr.emailAddresses == ['foo@example.com']", request: request, want: true},
+		"csr public key":         {expr: "cr.csr.publicKeyAlgorithm == 'ECDSA' && cr.csr.publicKeySize == 256", request: request, want: true},
+		"csr not set":            {expr: "!has(cr.csr)", request: newCertificateRequest("foo-ns"), want: true},
+		"dns names prefixed with namespace unless in group": {
+			expr:    "'group-1' in cr.groups || self.startsWith(cr.namespace + '.')",
+			request: newCertificateRequest("foo-ns"),
+			want:    false,
+		},
+		"invalid csr": {
+			expr:    "true",
+			request: cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{Request: []byte("foo")}},
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := &validator{expression: test.expr}
+			assert.NoError(t, v.compile())
+
+			cr, err := NewCertificateRequest(&test.request)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			got, err := v.Validate("bar.example.com", cr)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
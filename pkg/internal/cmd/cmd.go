@@ -122,10 +122,12 @@ func NewCommand(ctx context.Context) *cobra.Command {
 			log.Info("all approvers ready...")
 
 			if err := controllers.AddControllers(ctx, controllers.Options{
-				Log:         opts.Logr.WithName("controller"),
-				Manager:     mgr,
-				Evaluators:  registry.Shared.Evaluators(),
-				Reconcilers: registry.Shared.Reconcilers(),
+				Log:                   opts.Logr.WithName("controller"),
+				Manager:               mgr,
+				Evaluators:            registry.Shared.Evaluators(),
+				Reconcilers:           registry.Shared.Reconcilers(),
+				RBACAuthorizationMode: opts.RBACAuthorizationMode,
+				EvaluationConcurrency: opts.EvaluationConcurrency,
 			}); err != nil {
 				return fmt.Errorf("failed to add controllers: %w", err)
 			}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/manager/predicate"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -58,6 +60,14 @@ type Options struct {
 	// which will be served on the HTTP path '/readyz'.
 	ReadyzAddress string
 
+	// RBACAuthorizationMode defines how CertificateRequestPolicies are
+	// determined to be bound to the user in a CertificateRequest.
+	RBACAuthorizationMode predicate.RBACAuthorizationMode
+
+	// EvaluationConcurrency is the maximum number of policies that are
+	// evaluated concurrently when reviewing a CertificateRequest.
+	EvaluationConcurrency int
+
 	// RestConfig is the shared base rest config to connect to the Kubernetes
 	// API.
 	RestConfig *rest.Config
@@ -148,6 +158,14 @@ func (o *Options) Prepare(cmd *cobra.Command, approvers ...approver.Interface) *
 }
 
 func (o *Options) Complete() error {
+	if !slices.Contains(predicate.RBACAuthorizationModes, o.RBACAuthorizationMode) {
+		return fmt.Errorf("invalid --rbac-authorization-mode %q, must be one of %v", o.RBACAuthorizationMode, predicate.RBACAuthorizationModes)
+	}
+
+	if o.EvaluationConcurrency < 1 {
+		return fmt.Errorf("invalid --evaluation-concurrency %d, must be at least 1", o.EvaluationConcurrency)
+	}
+
 	opts := &slog.HandlerOptions{
 		// To avoid a breaking change in application configuration,
 		// we negate the (configured) logr verbosity level to get the corresponding slog level
@@ -214,6 +232,15 @@ func (o *Options) addAppFlags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&o.ReadyzAddress, "readiness-probe-bind-address", ":6060",
 		"TCP address for exposing the HTTP readiness probe which will be served on the HTTP path '/readyz'.")
+
+	fs.StringVar((*string)(&o.RBACAuthorizationMode), "rbac-authorization-mode", string(predicate.RBACAuthorizationModeInProcessWithFallback),
+		`How CertificateRequestPolicies are determined to be bound to requesting users. One of "in-process", which evaluates
+	 RBAC from the informer cache, "in-process-with-fallback", which additionally creates a SubjectAccessReview for
+	 policies not bound by RBAC, or "subject-access-review", which creates a SubjectAccessReview for every policy. Only
+	 use "in-process" if the API server authorizes requests with RBAC alone.`)
+
+	fs.IntVar(&o.EvaluationConcurrency, "evaluation-concurrency", 10,
+		"Maximum number of policies that are evaluated concurrently when reviewing a CertificateRequest.")
 }
 
 func (o *Options) addLoggingFlags(fs *pflag.FlagSet) {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -41,10 +43,12 @@ import (
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
 	"github.com/cert-manager/approver-policy/pkg/internal/controllers/ssa_client"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 // certificaterequestpolicies is a controller-runtime Reconciler which handles
-// the status of CertificateRequestPolicies. Status if built by approver
+// the status of CertificateRequestPolicies and
+// NamespacedCertificateRequestPolicies. Status if built by approver
 // Reconcilers determining the readiness.
 type certificaterequestpolicies struct {
 	// log is logger for the certificaterequestpolicies controller.
@@ -104,12 +108,20 @@ func addCertificateRequestPolicyController(_ context.Context, opts Options) erro
 					enqueueListSelect[chosen].Chan = reflect.ValueOf(nil)
 					continue
 				}
+				// Messages are either the name of a CertificateRequestPolicy, or the
+				// "namespace/name" key of a NamespacedCertificateRequestPolicy.
+				namespace, name, err := cache.SplitMetaNamespaceKey(val.String())
+				if err != nil {
+					log.Error(err, "failed to parse enqueued policy key", "key", val.String())
+					continue
+				}
+
 				// Send a message to the generic channel to cause a sync by the
 				// CertificateRequestPolicy controller.
 				select {
 				case <-ctx.Done():
 					return nil
-				case genericChan <- event.GenericEvent{Object: &policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: val.String()}}}:
+				case genericChan <- event.GenericEvent{Object: &policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}}:
 					// Continue with loop
 				}
 			}
@@ -120,10 +132,14 @@ func addCertificateRequestPolicyController(_ context.Context, opts Options) erro
 
 	return ctrl.NewControllerManagedBy(opts.Manager).
 		For(new(policyapi.CertificateRequestPolicy)).
+		// NamespacedCertificateRequestPolicies are reconciled by the same
+		// controller. Requests for these policies are distinguished by having a
+		// namespace.
+		Watches(new(policyapi.NamespacedCertificateRequestPolicy), new(handler.EnqueueRequestForObject)).
 		WatchesRawSource(source.Channel(genericChan, handler.EnqueueRequestsFromMapFunc(
 			func(_ context.Context, obj client.Object) []reconcile.Request {
-				log.Info("reconciling certificaterequestpolicy after receiving event message", "name", obj.GetName())
-				return []ctrl.Request{{NamespacedName: types.NamespacedName{Name: obj.GetName()}}}
+				log.Info("reconciling certificaterequestpolicy after receiving event message", "namespace", obj.GetNamespace(), "name", obj.GetName())
+				return []ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}}}
 			},
 		))).
 		Complete(&certificaterequestpolicies{
@@ -137,26 +153,38 @@ func addCertificateRequestPolicyController(_ context.Context, opts Options) erro
 }
 
 // Reconcile is the top level function for reconciling over synced
-// CertificateRequestPolicies.
-// Reconcile will be called whenever a CertificateRequestPolicy event happens.
+// CertificateRequestPolicies and NamespacedCertificateRequestPolicies.
+// Reconcile will be called whenever a CertificateRequestPolicy or
+// NamespacedCertificateRequestPolicy event happens.
 // This function will call each approver Reconciler to build the Ready state of
-// CertificateRequestPolicies.
+// the policy.
 func (c *certificaterequestpolicies) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	kind := policyKind(req)
+
 	result, patch, resultErr := c.reconcileStatusPatch(ctx, req)
 	if patch != nil {
-		crp, patch, err := ssa_client.GenerateCertificateRequestPolicyStatusPatch(req.Name, patch)
+		var (
+			obj      client.Object
+			patchObj client.Patch
+			err      error
+		)
+		if len(req.Namespace) > 0 {
+			obj, patchObj, err = ssa_client.GenerateNamespacedCertificateRequestPolicyStatusPatch(req.Name, req.Namespace, patch)
+		} else {
+			obj, patchObj, err = ssa_client.GenerateCertificateRequestPolicyStatusPatch(req.Name, patch)
+		}
 		if err != nil {
-			err = fmt.Errorf("failed to generate CertificateRequestPolicy.Status patch: %w", err)
+			err = fmt.Errorf("failed to generate %s.Status patch: %w", kind, err)
 			return ctrl.Result{}, utilerrors.NewAggregate([]error{resultErr, err})
 		}
 
-		if err := c.client.Status().Patch(ctx, crp, patch, &client.SubResourcePatchOptions{
+		if err := c.client.Status().Patch(ctx, obj, patchObj, &client.SubResourcePatchOptions{
 			PatchOptions: client.PatchOptions{
 				FieldManager: "approver-policy",
 				Force:        ptr.To(true),
 			},
 		}); err != nil {
-			err = fmt.Errorf("failed to apply CertificateRequestPolicy.Status patch: %w", err)
+			err = fmt.Errorf("failed to apply %s.Status patch: %w", kind, err)
 			return ctrl.Result{}, utilerrors.NewAggregate([]error{resultErr, err})
 		}
 	}
@@ -166,11 +194,33 @@ func (c *certificaterequestpolicies) Reconcile(ctx context.Context, req ctrl.Req
 
 func (c *certificaterequestpolicies) reconcileStatusPatch(ctx context.Context, req ctrl.Request) (ctrl.Result, *policyapi.CertificateRequestPolicyStatus, error) {
 	log := c.log.WithValues("name", req.NamespacedName.Name)
+	if len(req.Namespace) > 0 {
+		log = log.WithValues("namespace", req.Namespace)
+	}
 	log.V(2).Info("syncing")
 
-	policy := new(policyapi.CertificateRequestPolicy)
-	if err := c.lister.Get(ctx, req.NamespacedName, policy); err != nil {
-		return reconcile.Result{}, nil, client.IgnoreNotFound(err)
+	kind := policyKind(req)
+
+	var (
+		// policy is passed to approver Reconcilers. NamespacedCertificateRequestPolicies
+		// are converted to a CertificateRequestPolicy with their namespace set.
+		policy *policyapi.CertificateRequestPolicy
+
+		// object is the reconciled resource, used for recording events.
+		object client.Object
+	)
+	if len(req.Namespace) > 0 {
+		namespacedPolicy := new(policyapi.NamespacedCertificateRequestPolicy)
+		if err := c.lister.Get(ctx, req.NamespacedName, namespacedPolicy); err != nil {
+			return reconcile.Result{}, nil, client.IgnoreNotFound(err)
+		}
+		policy, object = util.CertificateRequestPolicyFromNamespaced(namespacedPolicy), namespacedPolicy
+	} else {
+		policy = new(policyapi.CertificateRequestPolicy)
+		if err := c.lister.Get(ctx, req.NamespacedName, policy); err != nil {
+			return reconcile.Result{}, nil, client.IgnoreNotFound(err)
+		}
+		object = policy
 	}
 
 	var (
@@ -185,7 +235,7 @@ func (c *certificaterequestpolicies) reconcileStatusPatch(ctx context.Context, r
 	for _, reconciler := range c.reconcilers {
 		response, err := reconciler.Ready(ctx, policy)
 		if err != nil {
-			return reconcile.Result{}, nil, fmt.Errorf("failed to evaluate ready state of CertificateRequestPolicy %q: %w", req.NamespacedName.Name, err)
+			return reconcile.Result{}, nil, fmt.Errorf("failed to evaluate ready state of %s %q: %w", kind, req.NamespacedName, err)
 		}
 
 		// If any response is not ready, set ready to false.
@@ -212,8 +262,8 @@ func (c *certificaterequestpolicies) reconcileStatusPatch(ctx context.Context, r
 	if !ready {
 		log.V(2).Info("NOT ready for approval evaluation", "errors", el.ToAggregate())
 
-		message := fmt.Sprintf("CertificateRequestPolicy is not ready for approval evaluation: %s", el.ToAggregate())
-		c.recorder.Event(policy, corev1.EventTypeWarning, "NotReady", message)
+		message := fmt.Sprintf("%s is not ready for approval evaluation: %s", kind, el.ToAggregate())
+		c.recorder.Event(object, corev1.EventTypeWarning, "NotReady", message)
 
 		c.setCertificateRequestPolicyCondition(
 			policy.Status.Conditions,
@@ -226,14 +276,15 @@ func (c *certificaterequestpolicies) reconcileStatusPatch(ctx context.Context, r
 				Message: message,
 			},
 		)
+		c.setActiveCondition(log, kind, policy, policyPatch, &result)
 
 		return result, policyPatch, nil
 	}
 
 	log.V(2).Info("ready for approval evaluation")
 
-	message := "CertificateRequestPolicy is ready for approval evaluation"
-	c.recorder.Event(policy, corev1.EventTypeNormal, "Ready", message)
+	message := fmt.Sprintf("%s is ready for approval evaluation", kind)
+	c.recorder.Event(object, corev1.EventTypeNormal, "Ready", message)
 
 	c.setCertificateRequestPolicyCondition(
 		policy.Status.Conditions,
@@ -246,10 +297,61 @@ func (c *certificaterequestpolicies) reconcileStatusPatch(ctx context.Context, r
 			Message: message,
 		},
 	)
+	c.setActiveCondition(log, kind, policy, policyPatch, &result)
 
 	return result, policyPatch, nil
 }
 
+// setActiveCondition sets the Active condition of policies which define a
+// validity period or schedule, and requeues the policy at the next time it may
+// become active or inactive. Policies which define neither are always active,
+// and have no Active condition.
+func (c *certificaterequestpolicies) setActiveCondition(log logr.Logger, kind string, policy *policyapi.CertificateRequestPolicy, policyPatch *policyapi.CertificateRequestPolicyStatus, result *ctrl.Result) {
+	if policy.Spec.ValidFrom == nil && policy.Spec.ValidUntil == nil && policy.Spec.Schedule == nil {
+		return
+	}
+
+	condition := policyapi.CertificateRequestPolicyCondition{
+		Type:   policyapi.CertificateRequestPolicyConditionActive,
+		Status: corev1.ConditionFalse,
+	}
+
+	now := c.clock.Now()
+	activity, err := util.PolicyActivityAt(&policy.Spec, now)
+	switch {
+	case err != nil:
+		condition.Reason = "InvalidSchedule"
+		condition.Message = fmt.Sprintf("%s schedule cannot be evaluated: %s", kind, err)
+	case activity.Active:
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = activity.Reason
+		condition.Message = fmt.Sprintf("%s is active", kind)
+	case activity.Reason == util.ActivityReasonNotYetValid:
+		condition.Reason = activity.Reason
+		condition.Message = fmt.Sprintf("%s is not active until %s", kind, policy.Spec.ValidFrom.UTC().Format(time.RFC3339))
+	case activity.Reason == util.ActivityReasonExpired:
+		condition.Reason = activity.Reason
+		condition.Message = fmt.Sprintf("%s expired at %s", kind, policy.Spec.ValidUntil.UTC().Format(time.RFC3339))
+	default:
+		condition.Reason = activity.Reason
+		condition.Message = fmt.Sprintf("%s is outside of its schedule", kind)
+	}
+
+	log.V(2).Info("evaluated active state", "active", activity.Active, "reason", condition.Reason)
+
+	c.setCertificateRequestPolicyCondition(policy.Status.Conditions, &policyPatch.Conditions, policy.Generation, condition)
+
+	// Requeue at the next boundary, unless a reconciler asked to be requeued
+	// sooner.
+	if !activity.Next.IsZero() {
+		requeueAfter := activity.Next.Sub(now)
+		if !result.Requeue || result.RequeueAfter > requeueAfter {
+			result.RequeueAfter = requeueAfter
+		}
+		result.Requeue = true
+	}
+}
+
 // setCertificateRequestPolicyCondition updates the CertificateRequestPolicy
 // object with the given condition.
 // Will overwrite any existing condition of the same type.
@@ -304,3 +406,13 @@ func (c *certificaterequestpolicies) setCertificateRequestPolicyCondition(
 	// the new condition into the slice.
 	*patchConditions = append(*patchConditions, newCondition)
 }
+
+// policyKind returns the kind of policy the given request is for.
+// CertificateRequestPolicies are cluster scoped, so requests with a namespace
+// are for NamespacedCertificateRequestPolicies.
+func policyKind(req ctrl.Request) string {
+	if len(req.Namespace) > 0 {
+		return policyapi.NamespacedCertificateRequestPolicyKind
+	}
+	return policyapi.CertificateRequestPolicyKind
+}
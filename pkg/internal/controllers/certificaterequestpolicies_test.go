@@ -401,6 +401,98 @@ func Test_certificaterequestpolicies_Reconcile(t *testing.T) {
 			},
 			expEvent: "Warning NotReady CertificateRequestPolicy is not ready for approval evaluation: foo: Forbidden: not allowed",
 		},
+		"if policy is within its schedule, set active and requeue at the end of the window": {
+			existingObjects: []runtime.Object{&policyapi.CertificateRequestPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Generation: policyGeneration, ResourceVersion: "3"},
+				TypeMeta:   metav1.TypeMeta{Kind: "CertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Schedule: &policyapi.CertificateRequestPolicySchedule{
+						Windows: []policyapi.CertificateRequestPolicyScheduleWindow{{Start: "00:30", End: "02:00"}},
+					},
+				},
+			}},
+			expResult: ctrl.Result{Requeue: true, RequeueAfter: time.Hour},
+			expError:  false,
+			expStatusPatch: &policyapi.CertificateRequestPolicyStatus{
+				Conditions: []policyapi.CertificateRequestPolicyCondition{
+					{Type: policyapi.CertificateRequestPolicyConditionReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "Ready",
+						Message:            "CertificateRequestPolicy is ready for approval evaluation",
+						ObservedGeneration: policyGeneration},
+					{Type: policyapi.CertificateRequestPolicyConditionActive,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "Active",
+						Message:            "CertificateRequestPolicy is active",
+						ObservedGeneration: policyGeneration},
+				},
+			},
+			expEvent: "Normal Ready CertificateRequestPolicy is ready for approval evaluation",
+		},
+		"if policy has expired, set not active and don't requeue": {
+			existingObjects: []runtime.Object{&policyapi.CertificateRequestPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Generation: policyGeneration, ResourceVersion: "3"},
+				TypeMeta:   metav1.TypeMeta{Kind: "CertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					ValidUntil: &metav1.Time{Time: fixedTime.Add(-time.Hour)},
+				},
+			}},
+			reconcilers: []approver.Reconciler{fakeapprover.NewFakeReconciler().WithReady(func(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
+				return approver.ReconcilerReadyResponse{Ready: true}, nil
+			})},
+			expResult: ctrl.Result{},
+			expError:  false,
+			expStatusPatch: &policyapi.CertificateRequestPolicyStatus{
+				Conditions: []policyapi.CertificateRequestPolicyCondition{
+					{Type: policyapi.CertificateRequestPolicyConditionReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "Ready",
+						Message:            "CertificateRequestPolicy is ready for approval evaluation",
+						ObservedGeneration: policyGeneration},
+					{Type: policyapi.CertificateRequestPolicyConditionActive,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "Expired",
+						Message:            "CertificateRequestPolicy expired at 2021-01-01T00:00:00Z",
+						ObservedGeneration: policyGeneration},
+				},
+			},
+			expEvent: "Normal Ready CertificateRequestPolicy is ready for approval evaluation",
+		},
+		"if policy is not yet valid and a reconciler requeues later, requeue at validFrom": {
+			existingObjects: []runtime.Object{&policyapi.CertificateRequestPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Generation: policyGeneration, ResourceVersion: "3"},
+				TypeMeta:   metav1.TypeMeta{Kind: "CertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					ValidFrom: &metav1.Time{Time: fixedTime.Add(time.Minute)},
+				},
+			}},
+			reconcilers: []approver.Reconciler{fakeapprover.NewFakeReconciler().WithReady(func(_ context.Context, _ *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
+				return approver.ReconcilerReadyResponse{Ready: true, Result: ctrl.Result{Requeue: true, RequeueAfter: time.Hour}}, nil
+			})},
+			expResult: ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			expError:  false,
+			expStatusPatch: &policyapi.CertificateRequestPolicyStatus{
+				Conditions: []policyapi.CertificateRequestPolicyCondition{
+					{Type: policyapi.CertificateRequestPolicyConditionReady,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "Ready",
+						Message:            "CertificateRequestPolicy is ready for approval evaluation",
+						ObservedGeneration: policyGeneration},
+					{Type: policyapi.CertificateRequestPolicyConditionActive,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: fixedmetatime,
+						Reason:             "NotYetValid",
+						Message:            "CertificateRequestPolicy is not active until 2021-01-01T01:01:00Z",
+						ObservedGeneration: policyGeneration},
+				},
+			},
+			expEvent: "Normal Ready CertificateRequestPolicy is ready for approval evaluation",
+		},
 		"if one reconciler returns ready but the other errors, return error": {
 			existingObjects: []runtime.Object{&policyapi.CertificateRequestPolicy{
 				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Generation: policyGeneration, ResourceVersion: "3"},
@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -45,6 +46,7 @@ import (
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver/manager"
 	internalmanager "github.com/cert-manager/approver-policy/pkg/internal/approver/manager"
+	approverpredicate "github.com/cert-manager/approver-policy/pkg/internal/approver/manager/predicate"
 	"github.com/cert-manager/approver-policy/pkg/internal/controllers/ssa_client"
 )
 
@@ -79,43 +81,33 @@ type certificaterequests struct {
 // addCertificateRequestController will register the certificaterequests
 // controller with the controller-runtime Manager.
 func addCertificateRequestController(ctx context.Context, opts Options) error {
+	// Index policies so that only the policies which may match a request are
+	// fetched from the cache when reviewing it.
+	if err := internalmanager.RegisterIndexes(ctx, opts.Manager.GetFieldIndexer()); err != nil {
+		return fmt.Errorf("failed to register policy indexes: %w", err)
+	}
+
 	c := &certificaterequests{
 		log:      opts.Log.WithName("certificaterequests"),
 		clock:    clock.RealClock{},
 		recorder: opts.Manager.GetEventRecorderFor("policy.cert-manager.io"),
 		client:   opts.Manager.GetClient(),
 		lister:   opts.Manager.GetCache(),
-		manager:  internalmanager.New(opts.Manager.GetCache(), opts.Manager.GetClient(), opts.Evaluators),
+		manager:  internalmanager.New(opts.Manager.GetCache(), true, opts.Manager.GetClient(), opts.RBACAuthorizationMode, opts.EvaluationConcurrency, opts.Evaluators),
 	}
 
 	enqueueRequestFromMapFunc := func(_ context.Context, _ client.Object) []reconcile.Request {
-		// If an error happens here and we do nothing, we run the risk of not
-		// processing CertificateRequests.
-		// Exiting error is the safest option, as it will force a resync on all
-		// CertificateRequests on start.
-		var crList cmapi.CertificateRequestList
-		if err := c.lister.List(ctx, &crList); err != nil {
-			c.log.Error(err, "failed to list all CertificateRequests, exiting error")
-			os.Exit(-1)
-		}
-
-		var requests []reconcile.Request
-		for _, cr := range crList.Items {
-			// Check for approval status early, rather than relying on the
-			// predicate or doing it in the actual Reconcile func.
-			if apiutil.CertificateRequestIsApproved(&cr) || /* #nosec G601 -- Func drops pointer at end of call. */
-				apiutil.CertificateRequestIsDenied(&cr) /* #nosec G601 -- Func drops pointer at end of call. */ {
-				continue
-			}
-			requests = append(requests, reconcile.Request{
-				NamespacedName: types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}},
-			)
-		}
+		return c.enqueueUnapprovedRequests(ctx)
+	}
 
-		return requests
+	// NamespacedCertificateRequestPolicies are only appropriate for
+	// CertificateRequests in the same namespace, so only those need to be
+	// processed.
+	enqueueNamespacedRequestFromMapFunc := func(_ context.Context, obj client.Object) []reconcile.Request {
+		return c.enqueueUnapprovedRequests(ctx, client.InNamespace(obj.GetNamespace()))
 	}
 
-	return ctrl.NewControllerManagedBy(opts.Manager).
+	b := ctrl.NewControllerManagedBy(opts.Manager).
 		For(&cmapi.CertificateRequest{}, builder.WithPredicates(
 			// Only process CertificateRequests which have not yet got an approval
 			// status.
@@ -125,25 +117,75 @@ func addCertificateRequestController(ctx context.Context, opts Options) error {
 			}),
 		)).
 
-		// Watch CertificateRequestPolicies. If a policy is created or updated,
-		// then we need to process all CertificateRequests that do not yet have an
-		// approved or denied condition since they may be relevant for the policy.
+		// Watch CertificateRequestPolicies and
+		// NamespacedCertificateRequestPolicies. If a policy is created or
+		// updated, then we need to process all CertificateRequests that do not
+		// yet have an approved or denied condition since they may be relevant
+		// for the policy.
 		Watches(&policyapi.CertificateRequestPolicy{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
+		Watches(&policyapi.NamespacedCertificateRequestPolicy{}, handler.EnqueueRequestsFromMapFunc(enqueueNamespacedRequestFromMapFunc)).
 
-		// Watch Roles, RoleBindings, ClusterRoles, and ClusterRoleBindings. If
-		// RBAC changes in the cluster then CertificateRequestPolicies may become
-		// appropriate for a CertificateRequest. On RBAC events, Reconcile all
-		// CertificateRequests that are neither Approved or Denied.
-		// Only need to cache metadata for RBAC resources since we do not need any
-		// information in the spec.
-		WatchesMetadata(&rbacv1.Role{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
-		WatchesMetadata(&rbacv1.RoleBinding{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
-		WatchesMetadata(&rbacv1.ClusterRole{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
-		WatchesMetadata(&rbacv1.ClusterRoleBinding{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
+		// Watch Namespaces, since policies may select CertificateRequests by
+		// the labels of their namespace.
 		WatchesMetadata(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc)).
 
-		// Complete the controller builder.
-		Complete(c)
+		// Watch CertificateRequestVotes, since requests which are pending
+		// manual approval may be approved or denied by a vote.
+		Watches(&policyapi.CertificateRequestVote{}, handler.EnqueueRequestsFromMapFunc(
+			func(_ context.Context, obj client.Object) []reconcile.Request {
+				vote := obj.(*policyapi.CertificateRequestVote)
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: vote.Namespace, Name: vote.Spec.CertificateRequestName}}}
+			},
+		))
+
+	// Watch Roles, RoleBindings, ClusterRoles, and ClusterRoleBindings. If
+	// RBAC changes in the cluster then CertificateRequestPolicies may become
+	// appropriate for a CertificateRequest. On RBAC events, Reconcile all
+	// CertificateRequests that are neither Approved or Denied.
+	// RBAC is evaluated in process from the informer cache unless only
+	// SubjectAccessReviews are used, in which case we only need to cache
+	// metadata for RBAC resources since we do not need any information in the
+	// spec.
+	for _, obj := range []client.Object{&rbacv1.Role{}, &rbacv1.RoleBinding{}, &rbacv1.ClusterRole{}, &rbacv1.ClusterRoleBinding{}} {
+		if opts.RBACAuthorizationMode == approverpredicate.RBACAuthorizationModeSubjectAccessReview {
+			b = b.WatchesMetadata(obj, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc))
+		} else {
+			b = b.Watches(obj, handler.EnqueueRequestsFromMapFunc(enqueueRequestFromMapFunc))
+		}
+	}
+
+	// Complete the controller builder.
+	return b.Complete(c)
+}
+
+// enqueueUnapprovedRequests returns reconcile requests for all
+// CertificateRequests, matching the given list options, that do not yet have
+// an approved or denied condition.
+func (c *certificaterequests) enqueueUnapprovedRequests(ctx context.Context, opts ...client.ListOption) []reconcile.Request {
+	// If an error happens here and we do nothing, we run the risk of not
+	// processing CertificateRequests.
+	// Exiting error is the safest option, as it will force a resync on all
+	// CertificateRequests on start.
+	var crList cmapi.CertificateRequestList
+	if err := c.lister.List(ctx, &crList, opts...); err != nil {
+		c.log.Error(err, "failed to list all CertificateRequests, exiting error")
+		os.Exit(-1)
+	}
+
+	var requests []reconcile.Request
+	for _, cr := range crList.Items {
+		// Check for approval status early, rather than relying on the
+		// predicate or doing it in the actual Reconcile func.
+		if apiutil.CertificateRequestIsApproved(&cr) || /* #nosec G601 -- Func drops pointer at end of call. */
+			apiutil.CertificateRequestIsDenied(&cr) /* #nosec G601 -- Func drops pointer at end of call. */ {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name}},
+		)
+	}
+
+	return requests
 }
 
 // Reconcile is the top level function for reconciling over synced
@@ -200,6 +242,11 @@ func (c *certificaterequests) reconcileStatusPatch(ctx context.Context, req ctrl
 		return ctrl.Result{}, nil, err
 	}
 
+	// Record the decisions of Audit policies before acting on the result.
+	if err := c.recordAudits(ctx, cr, response.Audits); err != nil {
+		return ctrl.Result{}, nil, err
+	}
+
 	crPatch := &cmapi.CertificateRequestStatus{}
 
 	switch response.Result {
@@ -236,6 +283,20 @@ func (c *certificaterequests) reconcileStatusPatch(ctx context.Context, req ctrl
 		return ctrl.Result{}, crPatch, nil
 
 	case manager.ResultUnprocessed:
+		if response.Pending {
+			log.V(2).Info("request is pending approval", "requeueAfter", response.RequeueAfter)
+			c.recorder.Event(cr, corev1.EventTypeNormal, "Pending", response.Message)
+
+			return ctrl.Result{RequeueAfter: response.RequeueAfter}, nil, nil
+		}
+
+		if response.RequeueAfter > 0 {
+			log.V(2).Info("request was delayed", "requeueAfter", response.RequeueAfter)
+			c.recorder.Event(cr, corev1.EventTypeNormal, "Delayed", response.Message)
+
+			return ctrl.Result{RequeueAfter: response.RequeueAfter}, nil, nil
+		}
+
 		log.V(2).Info("request was unprocessed")
 		c.recorder.Event(cr, corev1.EventTypeNormal, "Unprocessed", "Request is not applicable for any policy so ignoring")
 
@@ -251,6 +312,72 @@ func (c *certificaterequests) reconcileStatusPatch(ctx context.Context, req ctrl
 	}
 }
 
+// recordAudits records the decisions of Audit policies on the
+// CertificateRequest. Decisions are recorded in the audit annotation of the
+// CertificateRequest, and as events. The annotation is only updated, and
+// events only fired, when the decisions have changed since they were last
+// recorded.
+func (c *certificaterequests) recordAudits(ctx context.Context, cr *cmapi.CertificateRequest, audits []manager.AuditResponse) error {
+	var annotation string
+	if len(audits) > 0 {
+		results := make([]policyapi.CertificateRequestPolicyAuditResult, 0, len(audits))
+		for _, audit := range audits {
+			result := policyapi.CertificateRequestPolicyAuditResult{
+				Kind:    audit.Kind,
+				Name:    audit.Name,
+				Result:  "Approved",
+				Message: audit.Message,
+			}
+			if audit.Result == manager.ResultDenied {
+				result.Result = "Denied"
+			}
+			results = append(results, result)
+		}
+
+		encoded, err := json.Marshal(results)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit results: %w", err)
+		}
+		annotation = string(encoded)
+	}
+
+	// Nothing to do if the decisions haven't changed.
+	if cr.Annotations[policyapi.CertificateRequestAuditAnnotationKey] == annotation {
+		return nil
+	}
+
+	var annotations map[string]string
+	if len(annotation) > 0 {
+		annotations = map[string]string{policyapi.CertificateRequestAuditAnnotationKey: annotation}
+	}
+
+	crPatch, patch, err := ssa_client.GenerateCertificateRequestAnnotationsPatch(cr.Name, cr.Namespace, annotations)
+	if err != nil {
+		return fmt.Errorf("failed to generate CertificateRequest annotations patch: %w", err)
+	}
+
+	if err := c.client.Patch(ctx, crPatch, patch, &client.PatchOptions{
+		FieldManager: "approver-policy",
+		Force:        ptr.To(true),
+	}); err != nil {
+		return fmt.Errorf("failed to apply CertificateRequest annotations patch: %w", err)
+	}
+
+	for _, audit := range audits {
+		if audit.Result == manager.ResultDenied {
+			message := fmt.Sprintf("Audit %s %q would have denied the request", audit.Kind, audit.Name)
+			if len(audit.Message) > 0 {
+				message = fmt.Sprintf("%s: %s", message, audit.Message)
+			}
+			c.recorder.Event(cr, corev1.EventTypeWarning, "AuditDenied", message)
+		} else {
+			c.recorder.Eventf(cr, corev1.EventTypeNormal, "AuditApproved", "Audit %s %q would have approved the request", audit.Kind, audit.Name)
+		}
+	}
+
+	return nil
+}
+
 // Update the status with the provided condition details & return
 // the added condition.
 // This function is copied from https://github.com/cert-manager/issuer-lib/blob/main/conditions/certificaterequest.go
@@ -134,6 +134,26 @@ func Test_certificaterequests_Reconcile(t *testing.T) {
 			expStatusPatch: nil,
 			expEvent:       "Normal Unprocessed Request is not applicable for any policy so ignoring",
 		},
+		"if manager review returns an unprocessed response with requeue, fire event and requeue": {
+			existingObjects: []runtime.Object{gen.CertificateRequestFrom(baseRequest)},
+			manager: fakemanager.NewFakeManager().WithReview(func(context.Context, *cmapi.CertificateRequest) (manager.ReviewResponse, error) {
+				return manager.ReviewResponse{Result: manager.ResultUnprocessed, Message: "delayed result", RequeueAfter: time.Minute}, nil
+			}),
+			expResult:      ctrl.Result{RequeueAfter: time.Minute},
+			expError:       false,
+			expStatusPatch: nil,
+			expEvent:       "Normal Delayed delayed result",
+		},
+		"if manager review returns a pending response, fire event and do nothing": {
+			existingObjects: []runtime.Object{gen.CertificateRequestFrom(baseRequest)},
+			manager: fakemanager.NewFakeManager().WithReview(func(context.Context, *cmapi.CertificateRequest) (manager.ReviewResponse, error) {
+				return manager.ReviewResponse{Result: manager.ResultUnprocessed, Message: "pending result", Pending: true}, nil
+			}),
+			expResult:      ctrl.Result{},
+			expError:       false,
+			expStatusPatch: nil,
+			expEvent:       "Normal Pending pending result",
+		},
 		"if manager review returns denied, fire event and update request with denied": {
 			existingObjects: []runtime.Object{gen.CertificateRequestFrom(baseRequest)},
 			manager: fakemanager.NewFakeManager().WithReview(func(context.Context, *cmapi.CertificateRequest) (manager.ReviewResponse, error) {
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/controllers/ssa_client"
+)
+
+// voteNotFoundGracePeriod is how long after a vote is created that the
+// CertificateRequest it votes on may still be missing from the informer
+// cache, before the vote is recorded as being for a request which doesn't
+// exist.
+const voteNotFoundGracePeriod = time.Minute
+
+// certificaterequestvotes is a controller-runtime Reconciler which records
+// CertificateRequestVotes in events on the CertificateRequests they vote on.
+// Votes are only recorded once, which is marked in their status.
+type certificaterequestvotes struct {
+	// log is logger for the certificaterequestvotes controller.
+	log logr.Logger
+
+	// clock returns time which can be overwritten for testing.
+	clock clock.Clock
+
+	// recorder is used for creating Kubernetes events on resources.
+	recorder record.EventRecorder
+
+	// client is a Kubernetes REST client to interact with objects in the API
+	// server.
+	client client.Client
+
+	// lister makes requests to the informer cache for getting and listing
+	// objects.
+	lister client.Reader
+}
+
+// addCertificateRequestVoteController will register the
+// certificaterequestvotes controller with the controller-runtime Manager.
+func addCertificateRequestVoteController(_ context.Context, opts Options) error {
+	return ctrl.NewControllerManagedBy(opts.Manager).
+		For(new(policyapi.CertificateRequestVote), builder.WithPredicates(
+			// Only process votes which have not yet been recorded.
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.(*policyapi.CertificateRequestVote).Status.RecordedTime == nil
+			}),
+		)).
+		Complete(&certificaterequestvotes{
+			log:      opts.Log.WithName("certificaterequestvotes"),
+			clock:    clock.RealClock{},
+			recorder: opts.Manager.GetEventRecorderFor("policy.cert-manager.io"),
+			client:   opts.Manager.GetClient(),
+			lister:   opts.Manager.GetCache(),
+		})
+}
+
+// Reconcile is the top level function for reconciling over synced
+// CertificateRequestVotes. Reconcile records the vote in an event on the
+// CertificateRequest it votes on, then marks the vote as recorded.
+func (c *certificaterequestvotes) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, patch, resultErr := c.reconcileStatusPatch(ctx, req)
+	if patch != nil {
+		vote, patch, err := ssa_client.GenerateCertificateRequestVoteStatusPatch(req.Name, req.Namespace, patch)
+		if err != nil {
+			err = fmt.Errorf("failed to generate CertificateRequestVote.Status patch: %w", err)
+			return ctrl.Result{}, utilerrors.NewAggregate([]error{resultErr, err})
+		}
+
+		if err := c.client.Status().Patch(ctx, vote, patch, &client.SubResourcePatchOptions{
+			PatchOptions: client.PatchOptions{
+				FieldManager: "approver-policy",
+				Force:        ptr.To(true),
+			},
+		}); err != nil {
+			err = fmt.Errorf("failed to apply CertificateRequestVote.Status patch: %w", err)
+			return ctrl.Result{}, utilerrors.NewAggregate([]error{resultErr, err})
+		}
+	}
+
+	return result, resultErr
+}
+
+func (c *certificaterequestvotes) reconcileStatusPatch(ctx context.Context, req ctrl.Request) (ctrl.Result, *policyapi.CertificateRequestVoteStatus, error) {
+	log := c.log.WithValues("namespace", req.Namespace, "name", req.Name)
+	log.V(2).Info("syncing")
+
+	vote := new(policyapi.CertificateRequestVote)
+	if err := c.lister.Get(ctx, req.NamespacedName, vote); err != nil {
+		return ctrl.Result{}, nil, client.IgnoreNotFound(err)
+	}
+
+	if vote.Status.RecordedTime != nil {
+		return ctrl.Result{}, nil, nil
+	}
+
+	cr := new(cmapi.CertificateRequest)
+	err := c.lister.Get(ctx, types.NamespacedName{Namespace: vote.Namespace, Name: vote.Spec.CertificateRequestName}, cr)
+	if client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, nil, err
+	}
+
+	// A request of the same name with another UID is not the request that
+	// was voted on.
+	if err != nil || cr.UID != vote.Spec.CertificateRequestUID {
+		// The request may not have reached the informer cache yet, so wait
+		// for the grace period before giving up on it.
+		if age := c.clock.Since(vote.CreationTimestamp.Time); age < voteNotFoundGracePeriod {
+			log.V(2).Info("certificaterequest of vote not found, waiting for it to be observed", "requeueAfter", voteNotFoundGracePeriod-age)
+			return ctrl.Result{RequeueAfter: voteNotFoundGracePeriod - age}, nil, nil
+		}
+
+		// Votes are never reconsidered, so a vote for a request which doesn't
+		// exist is recorded on the vote itself.
+		log.V(2).Info("certificaterequest of vote not found")
+		c.recorder.Eventf(vote, corev1.EventTypeWarning, "NotFound", "CertificateRequest %q with UID %q not found",
+			vote.Spec.CertificateRequestName, vote.Spec.CertificateRequestUID)
+		return ctrl.Result{}, &policyapi.CertificateRequestVoteStatus{RecordedTime: &metav1.Time{Time: c.clock.Now()}}, nil
+	}
+
+	message := fmt.Sprintf("User %q voted to %s the request", vote.Spec.Username, voteVerb(vote.Spec.Decision))
+	if len(vote.Spec.Reason) > 0 {
+		message = fmt.Sprintf("%s: %s", message, vote.Spec.Reason)
+	}
+
+	log.V(2).Info("recording vote", "decision", vote.Spec.Decision, "user", vote.Spec.Username)
+	if vote.Spec.Decision == policyapi.CertificateRequestVoteDecisionDeny {
+		c.recorder.Event(cr, corev1.EventTypeWarning, "VoteDenied", message)
+	} else {
+		c.recorder.Event(cr, corev1.EventTypeNormal, "VoteApproved", message)
+	}
+
+	return ctrl.Result{}, &policyapi.CertificateRequestVoteStatus{RecordedTime: &metav1.Time{Time: c.clock.Now()}}, nil
+}
+
+// voteVerb returns the verb of a vote decision used in events.
+func voteVerb(decision policyapi.CertificateRequestVoteDecision) string {
+	if decision == policyapi.CertificateRequestVoteDecisionDeny {
+		return "deny"
+	}
+	return "approve"
+}
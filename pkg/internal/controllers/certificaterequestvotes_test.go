@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2/ktesting"
+	fakeclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+func Test_certificaterequestvotes_Reconcile(t *testing.T) {
+	const (
+		voteName    = "test-vote"
+		requestName = "test-request"
+	)
+
+	var (
+		fixedTime     = time.Date(2026, 01, 01, 01, 0, 0, 0, time.UTC)
+		fixedmetatime = &metav1.Time{Time: fixedTime}
+		fixedclock    = fakeclock.NewFakeClock(fixedTime)
+
+		request = gen.CertificateRequest(requestName, gen.SetCertificateRequestNamespace(gen.DefaultTestNamespace),
+			func(cr *cmapi.CertificateRequest) { cr.UID = "test-uid" })
+		vote = func(decision policyapi.CertificateRequestVoteDecision, reason string, recorded *metav1.Time) *policyapi.CertificateRequestVote {
+			return &policyapi.CertificateRequestVote{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         gen.DefaultTestNamespace,
+					Name:              voteName,
+					ResourceVersion:   "3",
+					CreationTimestamp: metav1.Time{Time: fixedTime.Add(-voteNotFoundGracePeriod)},
+				},
+				Spec: policyapi.CertificateRequestVoteSpec{
+					CertificateRequestName: requestName,
+					CertificateRequestUID:  "test-uid",
+					Decision:               decision,
+					Reason:                 reason,
+					Username:               "alice",
+				},
+				Status: policyapi.CertificateRequestVoteStatus{RecordedTime: recorded},
+			}
+		}
+	)
+
+	tests := map[string]struct {
+		existingObjects []runtime.Object
+
+		expError       bool
+		expResult      ctrl.Result
+		expStatusPatch *policyapi.CertificateRequestVoteStatus
+		expEvent       string
+	}{
+		"if vote doesn't exist, do nothing": {
+			existingObjects: nil,
+			expError:        false,
+			expStatusPatch:  nil,
+			expEvent:        "",
+		},
+		"if vote is already recorded, do nothing": {
+			existingObjects: []runtime.Object{request, vote(policyapi.CertificateRequestVoteDecisionApprove, "", fixedmetatime)},
+			expError:        false,
+			expStatusPatch:  nil,
+			expEvent:        "",
+		},
+		"if request doesn't exist within the grace period, requeue for the rest of the grace period": {
+			existingObjects: []runtime.Object{
+				withCreationTimestamp(vote(policyapi.CertificateRequestVoteDecisionApprove, "", nil), fixedTime.Add(-10*time.Second)),
+			},
+			expError:       false,
+			expResult:      ctrl.Result{RequeueAfter: voteNotFoundGracePeriod - 10*time.Second},
+			expStatusPatch: nil,
+			expEvent:       "",
+		},
+		"if request has another UID within the grace period, requeue for the rest of the grace period": {
+			existingObjects: []runtime.Object{
+				gen.CertificateRequestFrom(request, func(cr *cmapi.CertificateRequest) { cr.UID = "other-uid" }),
+				withCreationTimestamp(vote(policyapi.CertificateRequestVoteDecisionApprove, "", nil), fixedTime),
+			},
+			expError:       false,
+			expResult:      ctrl.Result{RequeueAfter: voteNotFoundGracePeriod},
+			expStatusPatch: nil,
+			expEvent:       "",
+		},
+		"if request doesn't exist after the grace period, fire event and mark vote as recorded": {
+			existingObjects: []runtime.Object{vote(policyapi.CertificateRequestVoteDecisionApprove, "", nil)},
+			expError:        false,
+			expStatusPatch:  &policyapi.CertificateRequestVoteStatus{RecordedTime: fixedmetatime},
+			expEvent:        `Warning NotFound CertificateRequest "test-request" with UID "test-uid" not found`,
+		},
+		"if request has another UID after the grace period, fire event and mark vote as recorded": {
+			existingObjects: []runtime.Object{
+				gen.CertificateRequestFrom(request, func(cr *cmapi.CertificateRequest) { cr.UID = "other-uid" }),
+				vote(policyapi.CertificateRequestVoteDecisionApprove, "", nil),
+			},
+			expError:       false,
+			expStatusPatch: &policyapi.CertificateRequestVoteStatus{RecordedTime: fixedmetatime},
+			expEvent:       `Warning NotFound CertificateRequest "test-request" with UID "test-uid" not found`,
+		},
+		"if vote approves, fire event and mark vote as recorded": {
+			existingObjects: []runtime.Object{request, vote(policyapi.CertificateRequestVoteDecisionApprove, "", nil)},
+			expError:        false,
+			expStatusPatch:  &policyapi.CertificateRequestVoteStatus{RecordedTime: fixedmetatime},
+			expEvent:        `Normal VoteApproved User "alice" voted to approve the request`,
+		},
+		"if vote denies with a reason, fire event with reason and mark vote as recorded": {
+			existingObjects: []runtime.Object{request, vote(policyapi.CertificateRequestVoteDecisionDeny, "unknown service", nil)},
+			expError:        false,
+			expStatusPatch:  &policyapi.CertificateRequestVoteStatus{RecordedTime: fixedmetatime},
+			expEvent:        `Warning VoteDenied User "alice" voted to deny the request: unknown service`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeclient := fakeclient.NewClientBuilder().
+				WithScheme(policyapi.GlobalScheme).
+				WithRuntimeObjects(test.existingObjects...).
+				Build()
+
+			fakerecorder := record.NewFakeRecorder(1)
+
+			c := &certificaterequestvotes{
+				client:   fakeclient,
+				lister:   fakeclient,
+				recorder: fakerecorder,
+				log:      ktesting.NewLogger(t, ktesting.DefaultConfig),
+				clock:    fixedclock,
+			}
+
+			result, statusPatch, err := c.reconcileStatusPatch(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: voteName}})
+			if (err != nil) != test.expError {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expError, err)
+			}
+
+			if result != test.expResult {
+				t.Errorf("unexpected Reconcile result, exp=%v got=%v", test.expResult, result)
+			}
+
+			var event string
+			select {
+			case event = <-fakerecorder.Events:
+			default:
+			}
+			if event != test.expEvent {
+				t.Errorf("unexpected event, exp=%q got=%q", test.expEvent, event)
+			}
+
+			if !apiequality.Semantic.DeepEqual(statusPatch, test.expStatusPatch) {
+				t.Errorf("unexpected Reconcile response, exp=%v got=%v", test.expStatusPatch, statusPatch)
+			}
+		})
+	}
+}
+
+func withCreationTimestamp(vote *policyapi.CertificateRequestVote, created time.Time) *policyapi.CertificateRequestVote {
+	vote.CreationTimestamp = metav1.Time{Time: created}
+	return vote
+}
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/manager/predicate"
 )
 
 // Options hold options for the internal approver-policy controllers.
@@ -42,6 +43,14 @@ type Options struct {
 	// Reconcilers is the list of registered Approver Reconcilers that  will be
 	// used to manager CertificateRequestPolicy Ready conditions.
 	Reconcilers []approver.Reconciler
+
+	// RBACAuthorizationMode defines how CertificateRequestPolicies are
+	// determined to be bound to the user in a CertificateRequest.
+	RBACAuthorizationMode predicate.RBACAuthorizationMode
+
+	// EvaluationConcurrency is the maximum number of policies that are
+	// evaluated concurrently when reviewing a CertificateRequest.
+	EvaluationConcurrency int
 }
 
 // AddControllers adds all internal controllers.
@@ -54,5 +63,9 @@ func AddControllers(ctx context.Context, opts Options) error {
 		return fmt.Errorf("failed to add certificaterequestpolicy controller: %w", err)
 	}
 
+	if err := addCertificateRequestVoteController(ctx, opts); err != nil {
+		return fmt.Errorf("failed to add certificaterequestvote controller: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/allowed"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/constraints"
+	"github.com/cert-manager/approver-policy/pkg/registry"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("Namespaced", func() {
+	var (
+		ctx = context.Background()
+
+		cancel    func()
+		namespace corev1.Namespace
+
+		policyName, namespacedPolicyName string
+		userCreateCRRoleName             string
+		userUsePolicyRoleName            string
+	)
+
+	JustBeforeEach(func() {
+		registry := new(registry.Registry).Store(allowed.Approver(), constraints.Approver())
+		ctx, cancel, namespace = startControllers(registry)
+
+		policy := policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{GenerateName: "namespaced-"},
+			Spec: policyapi.CertificateRequestPolicySpec{
+				Allowed:  &policyapi.CertificateRequestPolicyAllowed{DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.example.com"}}},
+				Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+			},
+		}
+		Expect(env.AdminClient.Create(ctx, &policy)).ToNot(HaveOccurred())
+		waitForReady(ctx, env.AdminClient, policy.Name)
+		policyName = policy.Name
+
+		namespacedPolicy := policyapi.NamespacedCertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, GenerateName: "namespaced-"},
+			Spec: policyapi.CertificateRequestPolicySpec{
+				Allowed:  &policyapi.CertificateRequestPolicyAllowed{DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"*.team-a.example.com"}}},
+				Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{Name: ptr.To("my-issuer")}},
+			},
+		}
+		Expect(env.AdminClient.Create(ctx, &namespacedPolicy)).ToNot(HaveOccurred())
+		waitForNamespacedReady(ctx, env.AdminClient, namespace.Name, namespacedPolicy.Name)
+		namespacedPolicyName = namespacedPolicy.Name
+
+		userCreateCRRoleName = bindUserToCreateCertificateRequest(ctx, env.AdminClient, namespace.Name)
+		userUsePolicyRoleName = bindUserToUseCertificateRequestPolicies(ctx, env.AdminClient, namespace.Name, policyName)
+	})
+
+	JustAfterEach(func() {
+		deleteRoleAndRoleBindings(ctx, namespace.Name, userUsePolicyRoleName, userCreateCRRoleName)
+		Expect(env.AdminClient.Delete(ctx, &policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Name: policyName}})).ToNot(HaveOccurred())
+		Expect(env.AdminClient.Delete(ctx, &policyapi.NamespacedCertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: namespace.Name, Name: namespacedPolicyName}})).ToNot(HaveOccurred())
+		cancel()
+	})
+
+	It("if both the policy and the namespaced policy approve the request, the CertificateRequest should be approved", func() {
+		crName := createCertificateRequest(ctx, env.UserClient, namespace.Name,
+			gen.SetCSRDNSNames("foo.team-a.example.com"),
+			gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "my-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+		)
+		waitForApproval(ctx, env.AdminClient, namespace.Name, crName)
+	})
+
+	It("if the policy approves but the namespaced policy denies the request, the CertificateRequest should be denied", func() {
+		crName := createCertificateRequest(ctx, env.UserClient, namespace.Name,
+			gen.SetCSRDNSNames("foo.team-b.example.com"),
+			gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "my-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+		)
+		waitForDenial(ctx, env.AdminClient, namespace.Name, crName)
+	})
+
+	It("if the namespaced policy does not select the request, the CertificateRequest should be approved by the policy", func() {
+		crName := createCertificateRequest(ctx, env.UserClient, namespace.Name,
+			gen.SetCSRDNSNames("foo.team-b.example.com"),
+			gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "other-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+		)
+		waitForApproval(ctx, env.AdminClient, namespace.Name, crName)
+	})
+})
@@ -18,6 +18,7 @@ package test
 
 import (
 	"context"
+	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -591,6 +592,35 @@ var _ = Context("Review", func() {
 		deleteRoleAndRoleBindings(ctx, namespace.Name, userUsePolicyRoleName, userCreateCRRoleName)
 	})
 
+	It("if an audit policy would deny the request, should neither approve or deny the request but record the audit", func() {
+		policy := policyapi.CertificateRequestPolicy{ObjectMeta: metav1.ObjectMeta{GenerateName: "audit-"},
+			Spec: policyapi.CertificateRequestPolicySpec{
+				Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit,
+				Allowed:     &policyapi.CertificateRequestPolicyAllowed{DNSNames: &policyapi.CertificateRequestPolicyAllowedStringSlice{Values: &[]string{"foo.example.com"}}},
+				Selector:    policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+			},
+		}
+		Expect(env.AdminClient.Create(ctx, &policy)).ToNot(HaveOccurred())
+		waitForReady(ctx, env.AdminClient, policy.Name)
+
+		userCreateCRRoleName := bindUserToCreateCertificateRequest(ctx, env.AdminClient, namespace.Name)
+		userUsePolicyRoleName := bindUserToUseCertificateRequestPolicies(ctx, env.AdminClient, namespace.Name, policy.Name)
+
+		crName := createCertificateRequest(ctx, env.UserClient, namespace.Name,
+			gen.SetCSRDNSNames("example.com"),
+			gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "my-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+		)
+
+		Eventually(func() string {
+			var cr cmapi.CertificateRequest
+			Expect(env.AdminClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: crName}, &cr)).To(Succeed())
+			return cr.Annotations[policyapi.CertificateRequestAuditAnnotationKey]
+		}).WithTimeout(time.Second * 10).WithPolling(time.Millisecond * 10).Should(ContainSubstring(`"name":"` + policy.Name + `","result":"Denied"`))
+		waitForNoApproveOrDeny(ctx, env.AdminClient, namespace.Name, crName)
+
+		deleteRoleAndRoleBindings(ctx, namespace.Name, userUsePolicyRoleName, userCreateCRRoleName)
+	})
+
 	Context("Reconcile consistency", func() {
 		It("If the policy is not ready, should have stable resource version", func() {
 			plugin.FakeReconciler = fake.NewFakeReconciler().WithReady(func(_ context.Context, policy *policyapi.CertificateRequestPolicy) (approver.ReconcilerReadyResponse, error) {
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/manager/predicate"
 	"github.com/cert-manager/approver-policy/pkg/internal/controllers"
 	"github.com/cert-manager/approver-policy/pkg/registry"
 	testenv "github.com/cert-manager/approver-policy/test/env"
@@ -123,6 +124,28 @@ func waitForNotReady(ctx context.Context, cl client.Client, name string) {
 	}).WithTimeout(time.Second*10).WithPolling(time.Millisecond*10).Should(BeTrue(), "expected policy to become not-ready")
 }
 
+// waitForNamespacedReady will wait for the
+// NamespacedCertificateRequestPolicy, given by namespace and name, become in a
+// Ready state. Will ensure the Ready condition has the same observed
+// Generation as the object's Generation.
+func waitForNamespacedReady(ctx context.Context, cl client.Client, ns, name string) {
+	Eventually(func() bool {
+		var policy policyapi.NamespacedCertificateRequestPolicy
+		Eventually(func() error {
+			return cl.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, &policy)
+		}).WithTimeout(time.Second * 10).WithPolling(time.Millisecond * 10).Should(Succeed())
+		for _, condition := range policy.Status.Conditions {
+			if condition.ObservedGeneration != policy.Generation {
+				return false
+			}
+			if condition.Type == policyapi.CertificateRequestPolicyConditionReady && condition.Status == corev1.ConditionTrue {
+				return true
+			}
+		}
+		return false
+	}).WithTimeout(time.Second*10).WithPolling(time.Millisecond*10).Should(BeTrue(), "expected namespaced policy to become ready")
+}
+
 var controllerLock sync.Mutex
 
 // startControllers will create a test Namespace and start the approver-policy
@@ -223,10 +246,12 @@ func startControllers(registry *registry.Registry) (context.Context, func(), cor
 	Expect(err).NotTo(HaveOccurred())
 
 	Expect(controllers.AddControllers(ctx, controllers.Options{
-		Log:         log.WithName("controllers"),
-		Manager:     mgr,
-		Evaluators:  registry.Evaluators(),
-		Reconcilers: registry.Reconcilers(),
+		Log:                   log.WithName("controllers"),
+		Manager:               mgr,
+		Evaluators:            registry.Evaluators(),
+		Reconcilers:           registry.Reconcilers(),
+		RBACAuthorizationMode: predicate.RBACAuthorizationModeInProcessWithFallback,
+		EvaluationConcurrency: 10,
 	})).NotTo(HaveOccurred())
 
 	By("Running Policy controller")
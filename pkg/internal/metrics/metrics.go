@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -26,6 +27,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 )
 
 var (
@@ -79,6 +82,23 @@ var (
 		},
 		nil,
 	)
+
+	// auditCount counts the number of CertificateRequests that Audit policies
+	// would have approved or denied, by looking at the audit annotation. For
+	// context, the annotation looks like this:
+	//
+	//  policy.cert-manager.io/audit: '[{"kind":"CertificateRequestPolicy","name":"mael","result":"Denied","message":"..."}]'
+	auditCount = prometheus.NewDesc(
+		"approverpolicy_certificaterequest_audit_count",
+		"Number of CertificateRequests that Audit policies would have approved or denied.",
+		[]string{
+			"namespace",
+			"kind",
+			"policy",
+			"result",
+		},
+		nil,
+	)
 )
 
 // You don't need to wait for the cache to be synced before calling this. This
@@ -112,6 +132,7 @@ func (cc collector) Collect(ch chan<- prometheus.Metric) {
 	collectCRsApproved(cc.ctx, cc.log, cc.cache, ch)
 	collectCRsDenied(cc.ctx, cc.log, cc.cache, ch)
 	collectCRsUnmatched(cc.log, cc.cache, ch)
+	collectCRsAudited(cc.ctx, cc.log, cc.cache, ch)
 }
 
 // hasSynced returns true if the cache has synced. Otherwise, it returns false.
@@ -249,6 +270,51 @@ func collectCRsUnmatched(logger logr.Logger, c cache.Cache, ch chan<- prometheus
 	}
 }
 
+func collectCRsAudited(ctx context.Context, log logr.Logger, c cache.Cache, ch chan<- prometheus.Metric) {
+	list := &cmapi.CertificateRequestList{}
+	err := c.List(ctx, list)
+	if err != nil {
+		log.Error(err, "unable to list CertificateRequests")
+		return
+	}
+
+	type label struct{ namespace, kind, policy, result string }
+
+	var labels []label
+	count := make(map[label]int)
+
+	for _, cr := range list.Items {
+		annotation, ok := cr.Annotations[policyapi.CertificateRequestAuditAnnotationKey]
+		if !ok {
+			continue
+		}
+
+		var results []policyapi.CertificateRequestPolicyAuditResult
+		if err := json.Unmarshal([]byte(annotation), &results); err != nil {
+			log.Error(err, "unable to decode audit annotation", "namespace", cr.Namespace, "name", cr.Name)
+			continue
+		}
+
+		for _, result := range results {
+			k := label{namespace: cr.Namespace, kind: result.Kind, policy: result.Name, result: result.Result}
+			_, exists := count[k]
+			if !exists {
+				labels = append(labels, k)
+			}
+			count[k] += 1
+		}
+	}
+
+	for _, key := range labels {
+		ch <- prometheus.MustNewConstMetric(
+			auditCount,
+			prometheus.GaugeValue,
+			float64(count[key]),
+			key.namespace, key.kind, key.policy, key.result,
+		)
+	}
+}
+
 // Returns "True" or "False", or "Unknown" if the condition with the given type
 // (e.g., "Approved" or "Denied") exists, or "" if the condition is not found.
 func getStatus(condTyp cmapi.CertificateRequestConditionType, conditions []cmapi.CertificateRequestCondition) cmmeta.ConditionStatus {
@@ -168,6 +168,44 @@ func Test_Metrics(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("audit_count counts the decisions of Audit policies recorded on CRs", func(t *testing.T) {
+		mock := mockCollector(t, []cmapi.CertificateRequest{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo1", Namespace: "bar"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo2", Namespace: "bar", Annotations: map[string]string{
+					"policy.cert-manager.io/audit": `[{"kind":"CertificateRequestPolicy","name":"strict","result":"Denied","message":"some error"}]`,
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo3", Namespace: "bar", Annotations: map[string]string{
+					"policy.cert-manager.io/audit": `[{"kind":"CertificateRequestPolicy","name":"strict","result":"Denied"},{"kind":"NamespacedCertificateRequestPolicy","name":"team","result":"Approved"}]`,
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo4", Namespace: "other", Annotations: map[string]string{
+					"policy.cert-manager.io/audit": `[{"kind":"CertificateRequestPolicy","name":"strict","result":"Approved"}]`,
+				}},
+			},
+			// Annotations which cannot be decoded are skipped.
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo5", Namespace: "other", Annotations: map[string]string{
+					"policy.cert-manager.io/audit": `not-json`,
+				}},
+			},
+		})
+		const expected = `
+			# HELP approverpolicy_certificaterequest_audit_count Number of CertificateRequests that Audit policies would have approved or denied.
+			# TYPE approverpolicy_certificaterequest_audit_count gauge
+			approverpolicy_certificaterequest_audit_count{kind="CertificateRequestPolicy",namespace="bar",policy="strict",result="Denied"} 2
+			approverpolicy_certificaterequest_audit_count{kind="NamespacedCertificateRequestPolicy",namespace="bar",policy="team",result="Approved"} 1
+			approverpolicy_certificaterequest_audit_count{kind="CertificateRequestPolicy",namespace="other",policy="strict",result="Approved"} 1
+		`
+		err := testutil.CollectAndCompare(mock, strings.NewReader(expected), "approverpolicy_certificaterequest_audit_count")
+		require.NoError(t, err)
+	})
+
 }
 
 func mockCollector(t *testing.T, crs []cmapi.CertificateRequest) *collector {
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// DNS patterns are matched label by label. A label of only "*" matches
+// exactly one label, and a label of only "**" matches one or more labels. All
+// other labels are matched literally. Patterns and names are normalised with
+// NormalizeDNSName before they are matched.
+
+const (
+	// DNSAnyLabel is the pattern label which matches exactly one label.
+	DNSAnyLabel = "*"
+
+	// DNSAnyLabels is the pattern label which matches one or more labels.
+	DNSAnyLabels = "**"
+)
+
+// dnsProfile maps and validates DNS names for lookup as defined by UTS #46,
+// so that case, width and compatibility variants of a name have the same
+// normalised form. Unlike idna.Lookup, the characters which are not allowed in
+// host names by STD3, such as "*" and "_", are allowed so that patterns and
+// names like "_acme-challenge.example.com" can be normalised.
+var dnsProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+
+// NormalizeDNSName returns the lowercase ASCII (punycode) form of the DNS
+// name, without a trailing dot, after applying the UTS #46 mapping. Returns an
+// error if the name cannot be converted.
+func NormalizeDNSName(name string) (string, error) {
+	return dnsProfile.ToASCII(strings.TrimSuffix(name, "."))
+}
+
+// NormalizeDNSNameKey returns the normalised form of the DNS name for use as
+// a key, such as in an index. Names which cannot be normalised are only
+// lowercased, so that keys of the same name are still equal. Keys must not be
+// used to decide whether names match.
+func NormalizeDNSNameKey(name string) string {
+	if normalized, err := NormalizeDNSName(name); err == nil {
+		return normalized
+	}
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// IsWildcardDNSName returns whether the DNS name contains a wildcard label,
+// such as "*.example.com".
+func IsWildcardDNSName(name string) bool {
+	for _, label := range strings.Split(name, ".") {
+		if strings.Contains(label, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// DNSSubset returns whether every member DNS name matches at least one of the
+// passed DNS patterns. Returns an error if a member, or a pattern it is
+// matched against, cannot be normalised, in which case whether it matches is
+// unknown.
+func DNSSubset(patterns, members []string) (bool, error) {
+	for _, member := range members {
+		ok, err := DNSContains(patterns, member)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// DNSContains returns whether the given DNS name matches at least one of the
+// passed DNS patterns. Returns an error if the name, or a pattern, cannot be
+// normalised.
+func DNSContains(patterns []string, member string) (bool, error) {
+	for _, pattern := range patterns {
+		ok, err := DNSMatches(pattern, member)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+// DNSMatches returns whether the given DNS name matches the DNS pattern.
+// Returns an error if the pattern or name cannot be normalised, in which case
+// whether they match is unknown. Callers must decide whether such a name
+// matches: it must not be allowed by an Allow policy, but must still be
+// matched by a Deny policy, so that a name can't be crafted to avoid one.
+func DNSMatches(pattern, name string) (bool, error) {
+	normalizedPattern, err := NormalizeDNSName(pattern)
+	if err != nil {
+		return false, fmt.Errorf("failed to normalise DNS pattern %q: %w", pattern, err)
+	}
+	normalizedName, err := NormalizeDNSName(name)
+	if err != nil {
+		return false, fmt.Errorf("failed to normalise DNS name %q: %w", name, err)
+	}
+
+	patternLabels := strings.Split(normalizedPattern, ".")
+	nameLabels := strings.Split(normalizedName, ".")
+
+	for _, label := range nameLabels {
+		if len(label) == 0 {
+			return false, nil
+		}
+	}
+
+	return matchLabels(patternLabels, nameLabels), nil
+}
+
+// matchLabels returns whether the given name labels match the pattern labels.
+func matchLabels(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == DNSAnyLabels {
+		for i := 1; i <= len(name); i++ {
+			if matchLabels(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 || (pattern[0] != DNSAnyLabel && pattern[0] != name[0]) {
+		return false
+	}
+
+	return matchLabels(pattern[1:], name[1:])
+}
+
+// ValidateDNSPattern returns an error if the DNS pattern contains a wildcard
+// which is not an entire label, or cannot be normalised.
+func ValidateDNSPattern(pattern string) error {
+	for _, label := range strings.Split(pattern, ".") {
+		if strings.Contains(label, "*") && label != DNSAnyLabel && label != DNSAnyLabels {
+			return fmt.Errorf("wildcard must be an entire label: %q", label)
+		}
+	}
+	if _, err := NormalizeDNSName(pattern); err != nil {
+		return fmt.Errorf("invalid DNS name: %w", err)
+	}
+	return nil
+}
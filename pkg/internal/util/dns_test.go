@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_DNSMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		exp     bool
+		expErr  bool
+	}{
+		{pattern: "example.com", name: "example.com", exp: true},
+		{pattern: "example.com", name: "EXAMPLE.com.", exp: true},
+		{pattern: "Example.COM.", name: "example.com", exp: true},
+		{pattern: "example.com", name: "a.example.com", exp: false},
+		{pattern: "*.example.com", name: "a.example.com", exp: true},
+		{pattern: "*.example.com", name: "a.b.example.com", exp: false},
+		{pattern: "*.example.com", name: "example.com", exp: false},
+		{pattern: "*example.com", name: "evilexample.com", exp: false},
+		{pattern: "*example.com", name: "example.com", exp: false},
+		{pattern: "*.example.com", name: "*.example.com", exp: true},
+		{pattern: "a.*.example.com", name: "a.b.example.com", exp: true},
+		{pattern: "api-*.example.com", name: "api-1.example.com", exp: false},
+		{pattern: "api-*.example.com", name: "api-*.example.com", exp: true},
+		{pattern: "**.example.com", name: "a.example.com", exp: true},
+		{pattern: "**.example.com", name: "a.b.c.example.com", exp: true},
+		{pattern: "**.example.com", name: "example.com", exp: false},
+		{pattern: "**.example.com", name: "evilexample.com", exp: false},
+		{pattern: "a.**.example.com", name: "a.b.c.example.com", exp: true},
+		{pattern: "a.**.example.com", name: "b.c.example.com", exp: false},
+		{pattern: "*.example.com", name: "a..example.com", exp: false},
+		{pattern: "bücher.example", name: "xn--bcher-kva.example", exp: true},
+		{pattern: "xn--bcher-kva.example", name: "BÜCHER.example", exp: true},
+		{pattern: "*.example", name: "bücher.example", exp: true},
+		{pattern: "example.com", name: "ｅｘａｍｐｌｅ.com", exp: true},
+		{pattern: "ｅｘａｍｐｌｅ．ｃｏｍ", name: "example.com", exp: true},
+		{pattern: "*.example.com", name: "a。example.com", exp: true},
+		{pattern: "ﬁle.example", name: "file.example", exp: true},
+		{pattern: "_acme-challenge.example.com", name: "_acme-challenge.example.com", exp: true},
+		{pattern: "*.example", name: "xn--a.example", expErr: true},
+		{pattern: "xn--a.example", name: "example", expErr: true},
+		{pattern: "*.example", name: "-a.example", expErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s", test.pattern, test.name), func(t *testing.T) {
+			got, err := DNSMatches(test.pattern, test.name)
+			if (err != nil) != test.expErr {
+				t.Errorf("unexpected error (%q, %q): expErr=%t got=%v",
+					test.pattern, test.name, test.expErr, err)
+			}
+			if got != test.exp {
+				t.Errorf("unexpected match (%q, %q): exp=%t got=%t",
+					test.pattern, test.name, test.exp, got)
+			}
+		})
+	}
+}
+
+func Test_IsWildcardDNSName(t *testing.T) {
+	tests := map[string]bool{
+		"example.com":     false,
+		"*.example.com":   true,
+		"a*.example.com":  true,
+		"a.*.example.com": true,
+	}
+
+	for name, exp := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsWildcardDNSName(name); got != exp {
+				t.Errorf("unexpected wildcard (%q): exp=%t got=%t", name, exp, got)
+			}
+		})
+	}
+}
+
+func Test_ValidateDNSPattern(t *testing.T) {
+	tests := map[string]bool{
+		"example.com":        true,
+		"*.example.com":      true,
+		"**.example.com":     true,
+		"a.*.**.example.com": true,
+		"*example.com":       false,
+		"api-*.example.com":  false,
+		"***.example.com":    false,
+		"xn--a.example.com":  false,
+	}
+
+	for pattern, exp := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			if err := ValidateDNSPattern(pattern); (err == nil) != exp {
+				t.Errorf("unexpected validation (%q): exp=%t got=%v", pattern, exp, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// IP patterns are either CIDRs (e.g. "10.20.0.0/16" or "fd00::/8"), IP
+// addresses (e.g. "10.20.0.1"), or strings which may include wildcards ('*')
+// which are matched against the canonical form of IP addresses. IPv4-mapped
+// IPv6 addresses and prefixes are matched as their IPv4 equivalent.
+
+// IPSubset returns whether every member IP address matches at least one of
+// the passed IP patterns.
+func IPSubset(patterns, members []string) bool {
+	for _, member := range members {
+		if !IPContains(patterns, member) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IPContains returns whether the given IP address matches at least one of the
+// passed IP patterns.
+func IPContains(patterns []string, member string) bool {
+	for _, pattern := range patterns {
+		if IPMatches(pattern, member) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IPMatches returns whether the given IP address matches the IP pattern. A
+// member which is not a valid IP address only matches wildcard patterns.
+func IPMatches(pattern, member string) bool {
+	addr, err := netip.ParseAddr(member)
+	if err != nil {
+		return strings.Contains(pattern, "*") && WildcardMatches(pattern, member)
+	}
+	addr = addr.Unmap()
+
+	switch {
+	case strings.Contains(pattern, "/"):
+		prefix, err := ParseCIDR(pattern)
+		if err != nil {
+			return false
+		}
+		return prefix.Contains(addr)
+
+	case strings.Contains(pattern, "*"):
+		return WildcardMatches(pattern, addr.String())
+
+	default:
+		patternAddr, err := netip.ParseAddr(pattern)
+		if err != nil {
+			return false
+		}
+		return patternAddr.Unmap() == addr
+	}
+}
+
+// ParseCIDR parses a CIDR IP pattern. IPv4-mapped IPv6 prefixes are returned
+// as their IPv4 equivalent, so that they contain IPv4 addresses.
+func ParseCIDR(pattern string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(pattern)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if addr := prefix.Addr(); addr.Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+	}
+
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_IPMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		member  string
+		exp     bool
+	}{
+		{pattern: "10.20.0.0/16", member: "10.20.1.2", exp: true},
+		{pattern: "10.20.0.0/16", member: "10.21.1.2", exp: false},
+		{pattern: "10.20.1.2/16", member: "10.20.3.4", exp: true},
+		{pattern: "10.20.0.0/16", member: "::ffff:10.20.1.2", exp: true},
+		{pattern: "::ffff:10.20.0.0/112", member: "10.20.1.2", exp: true},
+		{pattern: "::ffff:10.20.0.0/112", member: "10.21.1.2", exp: false},
+		{pattern: "fd00::/8", member: "fd12:3456::1", exp: true},
+		{pattern: "fd00::/8", member: "fe80::1", exp: false},
+		{pattern: "fd00::/8", member: "10.0.0.1", exp: false},
+		{pattern: "0.0.0.0/0", member: "fd00::1", exp: false},
+		{pattern: "10.20.0.0/33", member: "10.20.1.2", exp: false},
+		{pattern: "10.20.1.2", member: "10.20.1.2", exp: true},
+		{pattern: "10.20.1.2", member: "::ffff:10.20.1.2", exp: true},
+		{pattern: "::ffff:10.20.1.2", member: "10.20.1.2", exp: true},
+		{pattern: "fd00:0:0::1", member: "fd00::1", exp: true},
+		{pattern: "fd00::1", member: "fd00::2", exp: false},
+		{pattern: "10.0.*", member: "10.0.1.2", exp: true},
+		{pattern: "10.0.*", member: "::ffff:10.0.1.2", exp: true},
+		{pattern: "fd00:0:*", member: "fd00::1", exp: false},
+		{pattern: "*", member: "fd00::1", exp: true},
+		{pattern: "*", member: "foo", exp: true},
+		{pattern: "foo", member: "foo", exp: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s", test.pattern, test.member), func(t *testing.T) {
+			if got := IPMatches(test.pattern, test.member); got != test.exp {
+				t.Errorf("unexpected match (%q, %q): exp=%t got=%t",
+					test.pattern, test.member, test.exp, got)
+			}
+		})
+	}
+}
+
+func Test_IPSubset(t *testing.T) {
+	patterns := []string{"10.20.0.0/16", "fd00::/8"}
+
+	if !IPSubset(patterns, []string{"10.20.1.2", "fd00::1"}) {
+		t.Errorf("expected members to be a subset of %v", patterns)
+	}
+	if IPSubset(patterns, []string{"10.20.1.2", "192.168.0.1"}) {
+		t.Errorf("expected members not to be a subset of %v", patterns)
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+// CertificateRequestPolicyFromNamespaced returns a CertificateRequestPolicy
+// built from the given NamespacedCertificateRequestPolicy. The returned policy
+// keeps the namespace of the NamespacedCertificateRequestPolicy in its object
+// meta, which is how namespaced policies are distinguished from
+// CertificateRequestPolicies by predicates, evaluators, and reconcilers.
+func CertificateRequestPolicyFromNamespaced(policy *policyapi.NamespacedCertificateRequestPolicy) *policyapi.CertificateRequestPolicy {
+	return &policyapi.CertificateRequestPolicy{
+		ObjectMeta: *policy.ObjectMeta.DeepCopy(),
+		Spec:       *policy.Spec.DeepCopy(),
+		Status:     *policy.Status.DeepCopy(),
+	}
+}
+
+// IsNamespacedPolicy returns true if the given CertificateRequestPolicy was
+// built from a NamespacedCertificateRequestPolicy.
+func IsNamespacedPolicy(policy *policyapi.CertificateRequestPolicy) bool {
+	return len(policy.Namespace) > 0
+}
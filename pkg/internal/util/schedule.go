@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	// Time zones of schedules are loaded from the embedded database, so that
+	// they don't depend on the time zone database of the image.
+	_ "time/tzdata"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+// Reasons a policy is, or is not, active.
+const (
+	ActivityReasonActive          = "Active"
+	ActivityReasonNotYetValid     = "NotYetValid"
+	ActivityReasonExpired         = "Expired"
+	ActivityReasonOutsideSchedule = "OutsideSchedule"
+)
+
+// scheduleDays maps the days of schedule windows to weekdays.
+var scheduleDays = map[policyapi.CertificateRequestPolicyScheduleDay]time.Weekday{
+	"Sunday":    time.Sunday,
+	"Monday":    time.Monday,
+	"Tuesday":   time.Tuesday,
+	"Wednesday": time.Wednesday,
+	"Thursday":  time.Thursday,
+	"Friday":    time.Friday,
+	"Saturday":  time.Saturday,
+}
+
+// PolicyActivity is whether a policy is active at a point in time.
+type PolicyActivity struct {
+	// Active is true if the policy is active.
+	Active bool
+
+	// Reason is the reason the policy is, or is not, active.
+	Reason string
+
+	// Next is the next time at which the policy may become active or
+	// inactive. Zero if the policy never changes state again.
+	Next time.Time
+}
+
+// PolicyActivityAt returns whether the policy is active at the given time,
+// according to its validity period and schedule.
+func PolicyActivityAt(spec *policyapi.CertificateRequestPolicySpec, now time.Time) (PolicyActivity, error) {
+	if spec.ValidFrom != nil && now.Before(spec.ValidFrom.Time) {
+		return PolicyActivity{Reason: ActivityReasonNotYetValid, Next: spec.ValidFrom.Time}, nil
+	}
+	if spec.ValidUntil != nil && !now.Before(spec.ValidUntil.Time) {
+		return PolicyActivity{Reason: ActivityReasonExpired}, nil
+	}
+
+	activity := PolicyActivity{Active: true, Reason: ActivityReasonActive}
+	if spec.Schedule != nil {
+		var err error
+		if activity, err = scheduleActivityAt(spec.Schedule, now); err != nil {
+			return PolicyActivity{}, err
+		}
+	}
+
+	if spec.ValidUntil != nil && (activity.Next.IsZero() || spec.ValidUntil.Time.Before(activity.Next)) {
+		activity.Next = spec.ValidUntil.Time
+	}
+
+	return activity, nil
+}
+
+// scheduleActivityAt returns whether any window of the schedule contains the
+// given time, and the next start or end of a window after it.
+func scheduleActivityAt(schedule *policyapi.CertificateRequestPolicySchedule, now time.Time) (PolicyActivity, error) {
+	loc, err := ScheduleLocation(schedule)
+	if err != nil {
+		return PolicyActivity{}, err
+	}
+
+	activity := PolicyActivity{Reason: ActivityReasonOutsideSchedule}
+	today := now.In(loc)
+
+	for _, window := range schedule.Windows {
+		start, err := ParseScheduleTime(window.Start)
+		if err != nil {
+			return PolicyActivity{}, err
+		}
+		end, err := ParseScheduleTime(window.End)
+		if err != nil {
+			return PolicyActivity{}, err
+		}
+
+		days := make(map[time.Weekday]bool)
+		for _, day := range window.Days {
+			days[scheduleDays[day]] = true
+		}
+
+		// Windows which started yesterday may span midnight, and the next
+		// start of a window is at most a week away.
+		for d := -1; d <= 7; d++ {
+			day := time.Date(today.Year(), today.Month(), today.Day()+d, 0, 0, 0, 0, loc)
+			if len(days) > 0 && !days[day.Weekday()] {
+				continue
+			}
+
+			windowStart := time.Date(day.Year(), day.Month(), day.Day(), 0, int(start/time.Minute), 0, 0, loc)
+			windowEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, int(end/time.Minute), 0, 0, loc)
+			if end <= start {
+				windowEnd = windowEnd.AddDate(0, 0, 1)
+			}
+
+			if !now.Before(windowStart) && now.Before(windowEnd) {
+				activity.Active, activity.Reason = true, ActivityReasonActive
+			}
+
+			for _, boundary := range []time.Time{windowStart, windowEnd} {
+				if boundary.After(now) && (activity.Next.IsZero() || boundary.Before(activity.Next)) {
+					activity.Next = boundary
+				}
+			}
+		}
+	}
+
+	return activity, nil
+}
+
+// ScheduleLocation returns the location of the time zone of the schedule.
+func ScheduleLocation(schedule *policyapi.CertificateRequestPolicySchedule) (*time.Location, error) {
+	if schedule.TimeZone == nil {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(*schedule.TimeZone)
+}
+
+// ParseScheduleTime parses a time of day of a schedule window, in the format
+// "HH:MM", and returns it as the duration since midnight.
+func ParseScheduleTime(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil || len(value) != len("15:04") {
+		return 0, fmt.Errorf("time of day %q must be in the format HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ValidScheduleDay returns true if the given day is a known day of the week.
+func ValidScheduleDay(day policyapi.CertificateRequestPolicyScheduleDay) bool {
+	_, ok := scheduleDays[day]
+	return ok
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+func Test_PolicyActivityAt(t *testing.T) {
+	// 2026-01-05 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	weekdays := []policyapi.CertificateRequestPolicyScheduleDay{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+	officeHours := func(timeZone string) *policyapi.CertificateRequestPolicySchedule {
+		return &policyapi.CertificateRequestPolicySchedule{
+			TimeZone: ptr.To(timeZone),
+			Windows: []policyapi.CertificateRequestPolicyScheduleWindow{
+				{Days: weekdays, Start: "09:00", End: "17:00"},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		spec        policyapi.CertificateRequestPolicySpec
+		now         time.Time
+		expActivity PolicyActivity
+		expErr      bool
+	}{
+		"no validity period or schedule is always active": {
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Active: true, Reason: ActivityReasonActive},
+		},
+		"before validFrom is not yet valid until validFrom": {
+			spec:        policyapi.CertificateRequestPolicySpec{ValidFrom: &metav1.Time{Time: at(6, 0, 0)}},
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Reason: ActivityReasonNotYetValid, Next: at(6, 0, 0)},
+		},
+		"at validUntil is expired": {
+			spec:        policyapi.CertificateRequestPolicySpec{ValidUntil: &metav1.Time{Time: at(5, 10, 0)}},
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Reason: ActivityReasonExpired},
+		},
+		"within the validity period is active until validUntil": {
+			spec: policyapi.CertificateRequestPolicySpec{
+				ValidFrom:  &metav1.Time{Time: at(5, 10, 0)},
+				ValidUntil: &metav1.Time{Time: at(6, 0, 0)},
+			},
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Active: true, Reason: ActivityReasonActive, Next: at(6, 0, 0)},
+		},
+		"within a window is active until the end of the window": {
+			spec:        policyapi.CertificateRequestPolicySpec{Schedule: officeHours("Europe/London")},
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Active: true, Reason: ActivityReasonActive, Next: at(5, 17, 0)},
+		},
+		"at the end of a window is outside the schedule until the next window": {
+			spec:        policyapi.CertificateRequestPolicySpec{Schedule: officeHours("Europe/London")},
+			now:         at(5, 17, 0),
+			expActivity: PolicyActivity{Reason: ActivityReasonOutsideSchedule, Next: at(6, 9, 0)},
+		},
+		"on a day without a window is outside the schedule until the next day with one": {
+			spec:        policyapi.CertificateRequestPolicySpec{Schedule: officeHours("Europe/London")},
+			now:         at(10, 10, 0),
+			expActivity: PolicyActivity{Reason: ActivityReasonOutsideSchedule, Next: at(12, 9, 0)},
+		},
+		"windows are evaluated in the time zone of the schedule": {
+			spec:        policyapi.CertificateRequestPolicySpec{Schedule: officeHours("America/New_York")},
+			now:         at(5, 13, 0),
+			expActivity: PolicyActivity{Reason: ActivityReasonOutsideSchedule, Next: at(5, 14, 0)},
+		},
+		"a window which spans midnight is active on the following day": {
+			spec: policyapi.CertificateRequestPolicySpec{Schedule: &policyapi.CertificateRequestPolicySchedule{
+				Windows: []policyapi.CertificateRequestPolicyScheduleWindow{
+					{Days: []policyapi.CertificateRequestPolicyScheduleDay{"Friday"}, Start: "22:00", End: "02:00"},
+				},
+			}},
+			now:         at(10, 1, 0),
+			expActivity: PolicyActivity{Active: true, Reason: ActivityReasonActive, Next: at(10, 2, 0)},
+		},
+		"validUntil before the end of a window is the next change": {
+			spec: policyapi.CertificateRequestPolicySpec{
+				ValidUntil: &metav1.Time{Time: at(5, 12, 0)},
+				Schedule:   officeHours("UTC"),
+			},
+			now:         at(5, 10, 0),
+			expActivity: PolicyActivity{Active: true, Reason: ActivityReasonActive, Next: at(5, 12, 0)},
+		},
+		"an unknown time zone returns an error": {
+			spec:   policyapi.CertificateRequestPolicySpec{Schedule: officeHours("Mars/Olympus_Mons")},
+			now:    at(5, 10, 0),
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			activity, err := PolicyActivityAt(&test.spec, test.now)
+			if (err != nil) != test.expErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if !activity.Next.Equal(test.expActivity.Next) {
+				t.Errorf("unexpected next, exp=%s got=%s", test.expActivity.Next, activity.Next)
+			}
+			activity.Next, test.expActivity.Next = time.Time{}, time.Time{}
+			if activity != test.expActivity {
+				t.Errorf("unexpected activity, exp=%+v got=%+v", test.expActivity, activity)
+			}
+		})
+	}
+}
+
+func Test_ParseScheduleTime(t *testing.T) {
+	tests := map[string]time.Duration{
+		"00:00": 0,
+		"09:30": 9*time.Hour + 30*time.Minute,
+		"23:59": 23*time.Hour + 59*time.Minute,
+		"9:30":  -1,
+		"24:00": -1,
+		"12:60": -1,
+		"noon":  -1,
+	}
+
+	for value, exp := range tests {
+		t.Run(value, func(t *testing.T) {
+			got, err := ParseScheduleTime(value)
+			if (err != nil) != (exp < 0) {
+				t.Fatalf("unexpected error, exp=%t got=%v", exp < 0, err)
+			}
+			if err == nil && got != exp {
+				t.Errorf("unexpected time of day, exp=%s got=%s", exp, got)
+			}
+		})
+	}
+}
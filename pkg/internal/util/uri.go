@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// URI path patterns are matched segment by segment, in the same way that DNS
+// patterns are matched label by label. A segment of only "*" matches exactly
+// one segment, and a segment of only "**" matches one or more segments.
+
+// URIPathMatches returns whether the URI path matches the path pattern.
+func URIPathMatches(pattern, path string) bool {
+	return matchLabels(strings.Split(pattern, "/"), strings.Split(path, "/"))
+}
+
+// ValidateURIPathPattern returns an error if the URI path pattern contains a
+// wildcard which is not an entire segment.
+func ValidateURIPathPattern(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if strings.Contains(segment, "*") && segment != DNSAnyLabel && segment != DNSAnyLabels {
+			return fmt.Errorf("wildcard must be an entire path segment: %q", segment)
+		}
+	}
+	return nil
+}
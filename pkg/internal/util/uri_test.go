@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_URIPathMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		exp     bool
+	}{
+		{pattern: "/ns/default", path: "/ns/default", exp: true},
+		{pattern: "/ns/default", path: "/ns/default/sa/foo", exp: false},
+		{pattern: "/ns/*/sa/*", path: "/ns/default/sa/foo", exp: true},
+		{pattern: "/ns/*/sa/*", path: "/ns/default/sa/foo/bar", exp: false},
+		{pattern: "/ns/*/sa/*", path: "/ns/sa/foo", exp: false},
+		{pattern: "/ns/**", path: "/ns/default/sa/foo", exp: true},
+		{pattern: "/ns/**", path: "/ns", exp: false},
+		{pattern: "/ns/foo*", path: "/ns/foobar", exp: false},
+		{pattern: "*", path: "", exp: true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s", test.pattern, test.path), func(t *testing.T) {
+			if got := URIPathMatches(test.pattern, test.path); got != test.exp {
+				t.Errorf("unexpected match (%q, %q): exp=%t got=%t",
+					test.pattern, test.path, test.exp, got)
+			}
+		})
+	}
+}
+
+func Test_ValidateURIPathPattern(t *testing.T) {
+	tests := map[string]bool{
+		"/ns/default": true,
+		"/ns/*/sa/*":  true,
+		"/ns/**":      true,
+		"/ns/foo*":    false,
+		"/ns/*-sa":    false,
+	}
+
+	for pattern, exp := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			if err := ValidateURIPathPattern(pattern); (err == nil) != exp {
+				t.Errorf("unexpected validation (%q): exp=%t got=%v", pattern, exp, err)
+			}
+		})
+	}
+}
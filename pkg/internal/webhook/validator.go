@@ -21,17 +21,23 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
+	"github.com/cert-manager/approver-policy/pkg/internal/util"
 )
 
 // validator validates against policy.cert-manager.io resources.
@@ -41,7 +47,8 @@ type validator struct {
 	registeredPlugins []string
 	webhooks          []approver.Webhook
 
-	lister client.Reader
+	lister     client.Reader
+	validators validation.Cache
 }
 
 var _ admission.CustomValidator = &validator{}
@@ -59,19 +66,32 @@ func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) (adm
 	return nil, nil
 }
 
-// certificateRequestPolicy validates the given CertificateRequestPolicy with
-// the base validations, along with all webhook validations registered.
+// validate validates the given CertificateRequestPolicy or
+// NamespacedCertificateRequestPolicy with the base validations, along with all
+// webhook validations registered.
 func (v *validator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	policy, ok := obj.(*policyapi.CertificateRequestPolicy)
-	if !ok {
-		return nil, fmt.Errorf("expected a CertificateRequestPolicy, but got a %T", obj)
-	}
 	var (
 		fieldErrs field.ErrorList
 		warnings  admission.Warnings
 		fldPath   = field.NewPath("spec")
 	)
 
+	var policy *policyapi.CertificateRequestPolicy
+	switch obj := obj.(type) {
+	case *policyapi.CertificateRequestPolicy:
+		policy = obj
+	case *policyapi.NamespacedCertificateRequestPolicy:
+		policy = util.CertificateRequestPolicyFromNamespaced(obj)
+
+		// NamespacedCertificateRequestPolicies only ever apply to requests in
+		// their own namespace.
+		if policy.Spec.Selector.Namespace != nil {
+			fieldErrs = append(fieldErrs, field.Forbidden(fldPath.Child("selector", "namespace"), "namespace selector is not allowed on NamespacedCertificateRequestPolicies, they only apply to requests in their own namespace"))
+		}
+	default:
+		return nil, fmt.Errorf("expected a CertificateRequestPolicy or NamespacedCertificateRequestPolicy, but got a %T", obj)
+	}
+
 	// Ensure no plugin has been defined which is not registered.
 	var unrecognisedNames []string
 	for name := range policy.Spec.Plugins {
@@ -96,16 +116,90 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object) (admission
 		}
 	}
 
-	if policy.Spec.Selector.IssuerRef == nil && policy.Spec.Selector.Namespace == nil {
+	switch effect := policy.Spec.Effect; effect {
+	case "", policyapi.CertificateRequestPolicyEffectAllow, policyapi.CertificateRequestPolicyEffectDeny:
+	default:
+		fieldErrs = append(fieldErrs, field.NotSupported(fldPath.Child("effect"), effect, []string{
+			string(policyapi.CertificateRequestPolicyEffectAllow), string(policyapi.CertificateRequestPolicyEffectDeny),
+		}))
+	}
+
+	switch enforcement := policy.Spec.Enforcement; enforcement {
+	case "", policyapi.CertificateRequestPolicyEnforcementEnforce, policyapi.CertificateRequestPolicyEnforcementAudit:
+	default:
+		fieldErrs = append(fieldErrs, field.NotSupported(fldPath.Child("enforcement"), enforcement, []string{
+			string(policyapi.CertificateRequestPolicyEnforcementEnforce), string(policyapi.CertificateRequestPolicyEnforcementAudit),
+		}))
+	}
+
+	if validFrom, validUntil := policy.Spec.ValidFrom, policy.Spec.ValidUntil; validFrom != nil && validUntil != nil && !validUntil.After(validFrom.Time) {
+		fieldErrs = append(fieldErrs, field.Invalid(fldPath.Child("validUntil"), validUntil.UTC().Format(time.RFC3339), "must be after validFrom"))
+	}
+
+	if schedule := policy.Spec.Schedule; schedule != nil {
+		fieldErrs = append(fieldErrs, validateSchedule(schedule, fldPath.Child("schedule"))...)
+	}
+
+	if policy.Spec.Selector.IssuerRef == nil && policy.Spec.Selector.Namespace == nil && !util.IsNamespacedPolicy(policy) {
 		fieldErrs = append(fieldErrs, field.Required(fldPath.Child("selector"), "one of issuerRef or namespace must be defined, hint: `{}` on either matches everything"))
 	}
 
+	if issRefSel := policy.Spec.Selector.IssuerRef; issRefSel != nil {
+		switch matchMode := issRefSel.MatchMode; matchMode {
+		case "", policyapi.CertificateRequestPolicyMatchModeGlob, policyapi.CertificateRequestPolicyMatchModeDNS:
+		default:
+			fieldErrs = append(fieldErrs, field.NotSupported(fldPath.Child("selector", "issuerRef", "matchMode"), matchMode, []string{
+				string(policyapi.CertificateRequestPolicyMatchModeGlob), string(policyapi.CertificateRequestPolicyMatchModeDNS),
+			}))
+		}
+	}
+
+	if issRefSel := policy.Spec.Selector.IssuerRef; issRefSel != nil && issRefSel.MatchMode == policyapi.CertificateRequestPolicyMatchModeDNS {
+		fldPath := fldPath.Child("selector", "issuerRef")
+		if name := issRefSel.Name; name != nil {
+			if err := util.ValidateDNSPattern(*name); err != nil {
+				fieldErrs = append(fieldErrs, field.Invalid(fldPath.Child("name"), *name, err.Error()))
+			}
+		}
+		if group := issRefSel.Group; group != nil {
+			if err := util.ValidateDNSPattern(*group); err != nil {
+				fieldErrs = append(fieldErrs, field.Invalid(fldPath.Child("group"), *group, err.Error()))
+			}
+		}
+	}
+
 	if nsSel := policy.Spec.Selector.Namespace; nsSel != nil && len(nsSel.MatchLabels) > 0 {
 		if _, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: nsSel.MatchLabels}); err != nil {
 			fieldErrs = append(fieldErrs, field.Invalid(fldPath.Child("selector", "namespace", "matchLabels"), nsSel.MatchLabels, err.Error()))
 		}
 	}
 
+	if nsSel := policy.Spec.Selector.Namespace; nsSel != nil {
+		fldPath := fldPath.Child("selector", "namespace", "matchExpressions")
+		for i, requirement := range nsSel.MatchExpressions {
+			if _, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{requirement}}); err != nil {
+				fieldErrs = append(fieldErrs, field.Invalid(fldPath.Index(i), requirement, err.Error()))
+			}
+		}
+	}
+
+	if sel := policy.Spec.Selector.RequestLabels; sel != nil {
+		fieldErrs = append(fieldErrs, metav1validation.ValidateLabelSelector(sel, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector", "requestLabels"))...)
+	}
+	if sel := policy.Spec.Selector.RequestAnnotations; sel != nil {
+		fieldErrs = append(fieldErrs, metav1validation.ValidateLabelSelector(sel, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector", "requestAnnotations"))...)
+	}
+
+	if expr := policy.Spec.Selector.Expression; expr != nil {
+		if _, err := v.validators.GetSelector(*expr); err != nil {
+			fieldErrs = append(fieldErrs, field.Invalid(fldPath.Child("selector", "expression"), *expr, err.Error()))
+		}
+	}
+
+	if reqSel := policy.Spec.Selector.Requester; reqSel != nil {
+		fieldErrs = append(fieldErrs, validateRequester(reqSel, fldPath.Child("selector", "requester"))...)
+	}
+
 	allAllowed := true
 	for _, webhook := range v.webhooks {
 		response, err := webhook.Validate(ctx, policy)
@@ -135,3 +229,72 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object) (admission
 
 	return warnings, utilerrors.NewAggregate(errs)
 }
+
+// validateSchedule validates the time zone and windows of a schedule.
+func validateSchedule(schedule *policyapi.CertificateRequestPolicySchedule, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if _, err := util.ScheduleLocation(schedule); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("timeZone"), *schedule.TimeZone, err.Error()))
+	}
+
+	if len(schedule.Windows) == 0 {
+		el = append(el, field.Required(fldPath.Child("windows"), "at least one window must be defined"))
+	}
+
+	for i, window := range schedule.Windows {
+		fldPath := fldPath.Child("windows").Index(i)
+		for j, day := range window.Days {
+			if !util.ValidScheduleDay(day) {
+				el = append(el, field.NotSupported(fldPath.Child("days").Index(j), day, []string{
+					"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday",
+				}))
+			}
+		}
+		if _, err := util.ParseScheduleTime(window.Start); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("start"), window.Start, err.Error()))
+		}
+		if _, err := util.ParseScheduleTime(window.End); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("end"), window.End, err.Error()))
+		}
+	}
+
+	return el
+}
+
+// validateRequester validates that a requester selector names at least one
+// requester, and that its usernames, groups and service accounts are valid
+// patterns. A selector which names no requesters would otherwise match every
+// user.
+func validateRequester(requester *policyapi.CertificateRequestPolicySelectorRequester, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if len(requester.Usernames) == 0 && len(requester.Groups) == 0 && len(requester.ServiceAccounts) == 0 {
+		el = append(el, field.Required(fldPath, "one of usernames, groups or serviceAccounts must be defined"))
+	}
+
+	for i, username := range requester.Usernames {
+		if len(strings.TrimSpace(username)) == 0 {
+			el = append(el, field.Invalid(fldPath.Child("usernames").Index(i), username, "must not be empty"))
+		}
+	}
+	for i, group := range requester.Groups {
+		if len(strings.TrimSpace(group)) == 0 {
+			el = append(el, field.Invalid(fldPath.Child("groups").Index(i), group, "must not be empty"))
+		}
+	}
+
+	for i, sa := range requester.ServiceAccounts {
+		fldPath := fldPath.Child("serviceAccounts").Index(i)
+		// Wildcards are replaced with a valid character so the rest of the
+		// pattern can be validated as a name.
+		for _, msg := range apivalidation.IsDNS1123Label(strings.ReplaceAll(sa.Namespace, "*", "a")) {
+			el = append(el, field.Invalid(fldPath.Child("namespace"), sa.Namespace, msg))
+		}
+		for _, msg := range apivalidation.IsDNS1123Subdomain(strings.ReplaceAll(sa.Name, "*", "a")) {
+			el = append(el, field.Invalid(fldPath.Child("name"), sa.Name, msg))
+		}
+	}
+
+	return el
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +35,7 @@ import (
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
 	fakeapprover "github.com/cert-manager/approver-policy/pkg/approver/fake"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
 )
 
 func Test_validate(t *testing.T) {
@@ -66,7 +68,7 @@ func Test_validate(t *testing.T) {
 		"if the object being validated is not a CertificateRequestPolicy return an error": {
 			crp: &corev1.Pod{},
 
-			expectedError: ptr.To("expected a CertificateRequestPolicy, but got a *v1.Pod"),
+			expectedError: ptr.To("expected a CertificateRequestPolicy or NamespacedCertificateRequestPolicy, but got a *v1.Pod"),
 		},
 		"if the CertificateRequestPolicy refers to a plugin that is not registered return an error": {
 			crp: &policyapi.CertificateRequestPolicy{
@@ -109,6 +111,244 @@ func Test_validate(t *testing.T) {
 
 			expectedError: ptr.To("spec.selector.namespace.matchLabels: Invalid value: map[string]string{\"$%234\":\"8dsdk\"}: key: Invalid value: \"$%234\": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')"),
 		},
+		"if an invalid namespace match expression is defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"sandbox"}},
+								{Key: "tier", Operator: "Equals", Values: []string{"prod"}},
+								{Key: "team", Operator: metav1.LabelSelectorOpExists, Values: []string{"a"}},
+							},
+						},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`[spec.selector.namespace.matchExpressions[1]: Invalid value: v1.LabelSelectorRequirement{Key:"tier", Operator:"Equals", Values:[]string{"prod"}}: "Equals" is not a valid label selector operator, spec.selector.namespace.matchExpressions[2]: Invalid value: v1.LabelSelectorRequirement{Key:"team", Operator:"Exists", Values:[]string{"a"}}: values: Invalid value: []string{"a"}: values set must be empty for exists and does not exist]`),
+		},
+		"if invalid request label and annotation selectors are defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef:     &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						RequestLabels: &metav1.LabelSelector{MatchLabels: map[string]string{"$%234": "foo"}},
+						RequestAnnotations: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "foo", Operator: metav1.LabelSelectorOpIn},
+						}},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To("[spec.selector.requestLabels.matchLabels: Invalid value: \"$%234\": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]'), spec.selector.requestAnnotations.matchExpressions[0].values: Required value: must be specified when `operator` is 'In' or 'NotIn']"),
+		},
+		"if an invalid selector expression is defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef:  &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						Expression: ptr.To("cr.name"),
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`spec.selector.expression: Invalid value: "cr.name": got string, wanted bool result type`),
+		},
+		"if a valid selector expression is defined, it should pass": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef:  &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						Expression: ptr.To("'cert-manager.io/certificate-name' in cr.annotations && namespaceLabels['team'] == 'a'"),
+					},
+				},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+		},
+		"if a requester selector names no requesters, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						Requester: &policyapi.CertificateRequestPolicySelectorRequester{RequireRBACBinding: ptr.To(false)},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`spec.selector.requester: Required value: one of usernames, groups or serviceAccounts must be defined`),
+		},
+		"if a requester selector has invalid requesters, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						Requester: &policyapi.CertificateRequestPolicySelectorRequester{
+							Usernames: []string{""},
+							Groups:    []string{" "},
+							ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{
+								{Namespace: "sandbox-*", Name: "*"},
+								{Namespace: "", Name: "My_App"},
+							},
+						},
+					},
+				},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`[spec.selector.requester.usernames[0]: Invalid value: "": must not be empty, ` +
+				`spec.selector.requester.groups[0]: Invalid value: " ": must not be empty, ` +
+				`spec.selector.requester.serviceAccounts[1].namespace: Invalid value: "": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), ` +
+				`spec.selector.requester.serviceAccounts[1].name: Invalid value: "My_App": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]`),
+		},
+		"if a valid requester selector is defined, it should pass": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+						Requester: &policyapi.CertificateRequestPolicySelectorRequester{
+							ServiceAccounts: []policyapi.CertificateRequestPolicySelectorServiceAccount{{Namespace: "sandbox-*", Name: "*"}},
+						},
+					},
+				},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+		},
+		"if an unsupported enforcement is defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Enforcement: "DryRun",
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`spec.enforcement: Unsupported value: "DryRun": supported values: "Enforce", "Audit"`),
+		},
+		"if invalid DNS issuerRef patterns are defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{
+							Name: ptr.To("issuer-*"), Group: ptr.To("**.example.com"), MatchMode: policyapi.CertificateRequestPolicyMatchModeDNS,
+						},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`spec.selector.issuerRef.name: Invalid value: "issuer-*": wildcard must be an entire label: "issuer-*"`),
+		},
+		"if an unsupported issuerRef match mode is defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{MatchMode: "Regex"},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To(`spec.selector.issuerRef.matchMode: Unsupported value: "Regex": supported values: "Glob", "DNS"`),
+		},
+		"if an Audit enforcement is defined, it should pass": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Enforcement: policyapi.CertificateRequestPolicyEnforcementAudit,
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+		},
+		"if an unsupported effect is defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Effect: "Audit",
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+
+			expectedError: ptr.To("spec.effect: Unsupported value: \"Audit\": supported values: \"Allow\", \"Deny\""),
+		},
+		"if a Deny CertificateRequestPolicy passes validation, allow it": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Effect: policyapi.CertificateRequestPolicyEffectDeny,
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+		},
+		"if an invalid validity period and schedule are defined, return error": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					ValidFrom:  &metav1.Time{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+					ValidUntil: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+					Schedule: &policyapi.CertificateRequestPolicySchedule{
+						TimeZone: ptr.To("Mars/Olympus_Mons"),
+						Windows: []policyapi.CertificateRequestPolicyScheduleWindow{
+							{Days: []policyapi.CertificateRequestPolicyScheduleDay{"Monday", "Funday"}, Start: "9:00", End: "17:00"},
+						},
+					},
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+			expectedError: ptr.To(`[spec.validUntil: Invalid value: "2026-01-01T00:00:00Z": must be after validFrom, spec.schedule.timeZone: Invalid value: "Mars/Olympus_Mons": unknown time zone Mars/Olympus_Mons, spec.schedule.windows[0].days[1]: Unsupported value: "Funday": supported values: "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday", spec.schedule.windows[0].start: Invalid value: "9:00": time of day "9:00" must be in the format HH:MM]`),
+		},
+		"if a valid validity period and schedule are defined, it should pass": {
+			crp: &policyapi.CertificateRequestPolicy{
+				TypeMeta:   testTypeMeta,
+				ObjectMeta: testObjectMeta,
+				Spec: policyapi.CertificateRequestPolicySpec{
+					ValidFrom:  &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+					ValidUntil: &metav1.Time{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+					Schedule: &policyapi.CertificateRequestPolicySchedule{
+						TimeZone: ptr.To("Europe/London"),
+						Windows: []policyapi.CertificateRequestPolicyScheduleWindow{
+							{Days: []policyapi.CertificateRequestPolicyScheduleDay{"Monday", "Friday"}, Start: "09:00", End: "17:00"},
+							{Start: "22:00", End: "02:00"},
+						},
+					},
+					Selector: policyapi.CertificateRequestPolicySelector{
+						IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{},
+					},
+				},
+			},
+		},
 		"if a registered webhook does not allow CertificateRequestPolicy, return an error": {
 			crp: &policyapi.CertificateRequestPolicy{
 				TypeMeta:   testTypeMeta,
@@ -206,6 +446,38 @@ func Test_validate(t *testing.T) {
 			registeredPlugins: []string{"foo", "bar"},
 			webhooks:          []approver.Webhook{passingWebhook},
 		},
+		"if the NamespacedCertificateRequestPolicy defines a namespace selector, return an error": {
+			crp: &policyapi.NamespacedCertificateRequestPolicy{
+				TypeMeta:   metav1.TypeMeta{Kind: "NamespacedCertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy", ResourceVersion: "3"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{
+						Namespace: &policyapi.CertificateRequestPolicySelectorNamespace{MatchNames: []string{"*"}},
+					},
+				},
+			},
+			webhooks:      []approver.Webhook{passingWebhook},
+			expectedError: ptr.To("spec.selector.namespace: Forbidden: namespace selector is not allowed on NamespacedCertificateRequestPolicies, they only apply to requests in their own namespace"),
+		},
+		"if the NamespacedCertificateRequestPolicy has no selector, it should pass": {
+			crp: &policyapi.NamespacedCertificateRequestPolicy{
+				TypeMeta:   metav1.TypeMeta{Kind: "NamespacedCertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy", ResourceVersion: "3"},
+				Spec:       policyapi.CertificateRequestPolicySpec{},
+			},
+			webhooks: []approver.Webhook{passingWebhook},
+		},
+		"if the NamespacedCertificateRequestPolicy is not allowed by a webhook, return an error": {
+			crp: &policyapi.NamespacedCertificateRequestPolicy{
+				TypeMeta:   metav1.TypeMeta{Kind: "NamespacedCertificateRequestPolicy", APIVersion: "policy.cert-manager.io/v1alpha1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-policy", ResourceVersion: "3"},
+				Spec: policyapi.CertificateRequestPolicySpec{
+					Selector: policyapi.CertificateRequestPolicySelector{IssuerRef: &policyapi.CertificateRequestPolicySelectorIssuerRef{}},
+				},
+			},
+			webhooks:      []approver.Webhook{notAllowedWebhook},
+			expectedError: ptr.To("spec: Invalid value: \"foo\": some error occurred"),
+		},
 	}
 
 	for name, test := range tests {
@@ -214,7 +486,7 @@ func Test_validate(t *testing.T) {
 				WithScheme(policyapi.GlobalScheme).
 				Build()
 
-			v := &validator{lister: fakeclient, log: ktesting.NewLogger(t, ktesting.DefaultConfig), webhooks: test.webhooks, registeredPlugins: test.registeredPlugins}
+			v := &validator{lister: fakeclient, validators: validation.NewCache(), log: ktesting.NewLogger(t, ktesting.DefaultConfig), webhooks: test.webhooks, registeredPlugins: test.registeredPlugins}
 			gotWarnings, gotErr := v.validate(context.Background(), test.crp)
 			if test.expectedError == nil && gotErr != nil {
 				t.Errorf("unexpected error: %v", gotErr)
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+// voteValidator validates CertificateRequestVotes. Votes must be created by
+// the user they are cast by, so that the user of a vote can be trusted when
+// evaluating manual approval, and must name the UID of the request they vote
+// on.
+type voteValidator struct {
+	// lister is used to look up the CertificateRequests that are voted on.
+	lister client.Reader
+}
+
+var _ admission.CustomValidator = &voteValidator{}
+
+func (v *voteValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	vote, ok := obj.(*policyapi.CertificateRequestVote)
+	if !ok {
+		return nil, fmt.Errorf("expected a CertificateRequestVote, but got a %T", obj)
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		el      field.ErrorList
+		fldPath = field.NewPath("spec")
+	)
+
+	if vote.Spec.Username != req.UserInfo.Username {
+		el = append(el, field.Invalid(fldPath.Child("username"), vote.Spec.Username, "must be the user that creates the vote"))
+	}
+	if !sets.New(vote.Spec.Groups...).Equal(sets.New(req.UserInfo.Groups...)) {
+		el = append(el, field.Invalid(fldPath.Child("groups"), vote.Spec.Groups, "must be the groups of the user that creates the vote"))
+	}
+
+	namespace := vote.Namespace
+	if len(namespace) == 0 {
+		namespace = req.Namespace
+	}
+	cr := new(cmapi.CertificateRequest)
+	switch err := v.lister.Get(ctx, types.NamespacedName{Namespace: namespace, Name: vote.Spec.CertificateRequestName}, cr); {
+	case apierrors.IsNotFound(err):
+		el = append(el, field.NotFound(fldPath.Child("certificateRequestName"), vote.Spec.CertificateRequestName))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get certificaterequest: %w", err)
+	case cr.UID != vote.Spec.CertificateRequestUID:
+		el = append(el, field.Invalid(fldPath.Child("certificateRequestUID"), vote.Spec.CertificateRequestUID, "must be the UID of the CertificateRequest"))
+	}
+
+	return nil, el.ToAggregate()
+}
+
+// ValidateUpdate always allows updates, the spec of votes is immutable.
+func (v *voteValidator) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *voteValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// always allow deletes
+	return nil, nil
+}
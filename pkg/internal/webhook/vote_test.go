@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
+)
+
+func Test_voteValidator_ValidateCreate(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	userInfo := authenticationv1.UserInfo{Username: "alice", Groups: []string{"security", "system:authenticated"}}
+	request := &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-a", Name: "request-a", UID: "request-a-uid"}}
+
+	tests := map[string]struct {
+		spec     policyapi.CertificateRequestVoteSpec
+		expError error
+	}{
+		"if the vote is cast by the creating user, return no error": {
+			spec: policyapi.CertificateRequestVoteSpec{CertificateRequestName: "request-a", CertificateRequestUID: "request-a-uid", Username: "alice", Groups: []string{"system:authenticated", "security"}},
+		},
+		"if the vote is cast by another user, return error": {
+			spec: policyapi.CertificateRequestVoteSpec{CertificateRequestName: "request-a", CertificateRequestUID: "request-a-uid", Username: "bob", Groups: []string{"security", "system:authenticated"}},
+			expError: field.ErrorList{
+				field.Invalid(fldPath.Child("username"), "bob", "must be the user that creates the vote"),
+			}.ToAggregate(),
+		},
+		"if the vote claims groups the creating user isn't in, return error": {
+			spec: policyapi.CertificateRequestVoteSpec{CertificateRequestName: "request-a", CertificateRequestUID: "request-a-uid", Username: "alice", Groups: []string{"security", "system:authenticated", "admins"}},
+			expError: field.ErrorList{
+				field.Invalid(fldPath.Child("groups"), []string{"security", "system:authenticated", "admins"}, "must be the groups of the user that creates the vote"),
+			}.ToAggregate(),
+		},
+		"if the voted on request doesn't exist, return error": {
+			spec: policyapi.CertificateRequestVoteSpec{CertificateRequestName: "request-b", CertificateRequestUID: "request-a-uid", Username: "alice", Groups: []string{"security", "system:authenticated"}},
+			expError: field.ErrorList{
+				field.NotFound(fldPath.Child("certificateRequestName"), "request-b"),
+			}.ToAggregate(),
+		},
+		"if the vote names another UID than the voted on request, return error": {
+			spec: policyapi.CertificateRequestVoteSpec{CertificateRequestName: "request-a", CertificateRequestUID: "request-b-uid", Username: "alice", Groups: []string{"security", "system:authenticated"}},
+			expError: field.ErrorList{
+				field.Invalid(fldPath.Child("certificateRequestUID"), types.UID("request-b-uid"), "must be the UID of the CertificateRequest"),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := admission.NewContextWithRequest(context.TODO(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{UserInfo: userInfo},
+			})
+			validator := &voteValidator{
+				lister: fakeclient.NewClientBuilder().WithScheme(policyapi.GlobalScheme).WithObjects(request).Build(),
+			}
+			vote := &policyapi.CertificateRequestVote{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-a", Name: "vote-1"},
+				Spec:       test.spec,
+			}
+			_, err := validator.ValidateCreate(ctx, vote)
+			assert.Equal(t, test.expError, err)
+		})
+	}
+}
@@ -21,11 +21,13 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	policyapi "github.com/cert-manager/approver-policy/pkg/apis/policy/v1alpha1"
 	"github.com/cert-manager/approver-policy/pkg/approver"
+	"github.com/cert-manager/approver-policy/pkg/internal/approver/validation"
 	"github.com/cert-manager/approver-policy/pkg/registry"
 )
 
@@ -60,15 +62,28 @@ func Register(ctx context.Context, opts Options) error {
 	validator := &validator{
 		log:               log.WithName("validation"),
 		lister:            opts.Manager.GetCache(),
+		validators:        validation.NewCache(),
 		webhooks:          opts.Webhooks,
 		registeredPlugins: registerdPlugins,
 	}
 
-	err := builder.WebhookManagedBy(opts.Manager).
-		For(&policyapi.CertificateRequestPolicy{}).
-		WithValidator(validator).
-		Complete()
-	if err != nil {
+	for _, obj := range []runtime.Object{
+		&policyapi.CertificateRequestPolicy{},
+		&policyapi.NamespacedCertificateRequestPolicy{},
+	} {
+		err := builder.WebhookManagedBy(opts.Manager).
+			For(obj).
+			WithValidator(validator).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("error registering webhook: %v", err)
+		}
+	}
+
+	if err := builder.WebhookManagedBy(opts.Manager).
+		For(&policyapi.CertificateRequestVote{}).
+		WithValidator(&voteValidator{lister: opts.Manager.GetCache()}).
+		Complete(); err != nil {
 		return fmt.Errorf("error registering webhook: %v", err)
 	}
 